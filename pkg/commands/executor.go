@@ -17,6 +17,8 @@ type Executor struct {
 	runCmd  *cobra.Command
 
 	exitCode              int
+	issuesCount           int
+	debtScore             *report.DebtScore
 	version, commit, date string
 
 	cfg               *config.Config
@@ -26,6 +28,8 @@ type Executor struct {
 	EnabledLintersSet *lintersdb.EnabledSet
 	contextLoader     *lint.ContextLoader
 	goenv             *goutil.Env
+
+	lintersWithChecks bool
 }
 
 func NewExecutor(version, commit, date string) *Executor {
@@ -74,9 +78,9 @@ func NewExecutor(version, commit, date string) *Executor {
 	// Slice options must be explicitly set for proper merging of config and command-line options.
 	fixSlicesFlags(e.runCmd.Flags())
 
-	e.EnabledLintersSet = lintersdb.NewEnabledSet(e.DBManager,
-		lintersdb.NewValidator(e.DBManager), e.log.Child("lintersdb"), e.cfg)
 	e.goenv = goutil.NewEnv(e.log.Child("goenv"))
+	e.EnabledLintersSet = lintersdb.NewEnabledSet(e.DBManager,
+		lintersdb.NewValidator(e.DBManager), e.log.Child("lintersdb"), e.cfg, e.goenv)
 	e.contextLoader = lint.NewContextLoader(e.cfg, e.log.Child("loader"), e.goenv)
 
 	return e
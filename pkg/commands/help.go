@@ -35,7 +35,7 @@ func (e *Executor) initHelp() {
 	helpCmd.AddCommand(lintersHelpCmd)
 }
 
-func printLinterConfigs(lcs []*linter.Config) {
+func printLinterConfigs(lcs []*linter.Config, withChecks bool) {
 	for _, lc := range lcs {
 		altNamesStr := ""
 		if len(lc.AlternativeNames) != 0 {
@@ -43,6 +43,16 @@ func printLinterConfigs(lcs []*linter.Config) {
 		}
 		fmt.Fprintf(logutils.StdOut, "%s%s: %s [fast: %t]\n", color.YellowString(lc.Name()),
 			altNamesStr, lc.Linter.Desc(), !lc.NeedsSSARepr)
+
+		if withChecks {
+			for _, c := range getLinterChecks(lc) {
+				if c.Desc == "" {
+					fmt.Fprintf(logutils.StdOut, "  %s\n", c.ID)
+				} else {
+					fmt.Fprintf(logutils.StdOut, "  %s: %s\n", c.ID, c.Desc)
+				}
+			}
+		}
 	}
 }
 
@@ -61,9 +71,9 @@ func (e *Executor) executeLintersHelp(_ *cobra.Command, args []string) {
 	}
 
 	color.Green("Enabled by default linters:\n")
-	printLinterConfigs(enabledLCs)
+	printLinterConfigs(enabledLCs, false)
 	color.Red("\nDisabled by default linters:\n")
-	printLinterConfigs(disabledLCs)
+	printLinterConfigs(disabledLCs, false)
 
 	color.Green("\nLinters presets:")
 	for _, p := range e.DBManager.AllPresets() {
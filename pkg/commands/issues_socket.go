@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// dialIssuesSocket connects to the address like unix:/tmp/gcl.sock or tcp:localhost:8080.
+func dialIssuesSocket(addr string) (net.Conn, error) {
+	parts := strings.SplitN(addr, ":", 2)
+	if len(parts) != 2 || (parts[0] != "unix" && parts[0] != "tcp") {
+		return nil, fmt.Errorf("invalid issues socket %q: must be unix:PATH or tcp:HOST:PORT", addr)
+	}
+
+	return net.Dial(parts[0], parts[1])
+}
+
+// streamIssuesToSocket writes every issue as a JSON line to the socket while passing them through:
+// tools like IDEs show issues before the whole output is printed. Socket errors don't fail the run.
+func (e *Executor) streamIssuesToSocket(issues <-chan result.Issue, addr string) <-chan result.Issue {
+	conn, err := dialIssuesSocket(addr)
+	if err != nil {
+		e.log.Warnf("Can't connect to issues socket: %s", err)
+		return issues
+	}
+
+	resCh := make(chan result.Issue, 1024)
+
+	go func() {
+		defer close(resCh)
+		defer conn.Close()
+
+		w := bufio.NewWriter(conn)
+		enc := json.NewEncoder(w)
+		failed := false
+		for i := range issues {
+			if !failed {
+				err := enc.Encode(i)
+				if err == nil {
+					err = w.Flush()
+				}
+				if err != nil {
+					e.log.Warnf("Can't write issue to issues socket: %s", err)
+					failed = true
+				}
+			}
+			resCh <- i
+		}
+	}()
+
+	return resCh
+}
@@ -1,13 +1,19 @@
 package commands
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"log"
 	"os"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
+	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/logutils"
 )
 
 func (e *Executor) initLinters() {
@@ -18,6 +24,12 @@ func (e *Executor) initLinters() {
 	}
 	e.rootCmd.AddCommand(lintersCmd)
 	e.initRunConfiguration(lintersCmd)
+	initLintersFlagSet(lintersCmd.Flags(), &e.lintersWithChecks)
+}
+
+func initLintersFlagSet(fs *pflag.FlagSet, withChecks *bool) {
+	fs.BoolVar(withChecks, "with-checks", false,
+		wh("Print checks which linters can report issues of (if the linter has a registry of checks)"))
 }
 
 func IsLinterInConfigsList(name string, linters []*linter.Config) bool {
@@ -30,19 +42,57 @@ func IsLinterInConfigsList(name string, linters []*linter.Config) bool {
 	return false
 }
 
+type lintersJSONLinter struct {
+	Name   string
+	Desc   string
+	Checks []linter.Check `json:",omitempty"`
+}
+
+type lintersJSONResult struct {
+	Enabled  []lintersJSONLinter
+	Disabled []lintersJSONLinter
+}
+
+func makeLintersJSONLinters(lcs []*linter.Config, withChecks bool) []lintersJSONLinter {
+	ret := []lintersJSONLinter{}
+	for _, lc := range lcs {
+		l := lintersJSONLinter{
+			Name: lc.Name(),
+			Desc: lc.Linter.Desc(),
+		}
+		if withChecks {
+			l.Checks = getLinterChecks(lc)
+		}
+		ret = append(ret, l)
+	}
+
+	return ret
+}
+
+func getLinterChecks(lc *linter.Config) []linter.Check {
+	cl, ok := lc.Linter.(linter.CheckLister)
+	if !ok {
+		return nil
+	}
+
+	return cl.Checks()
+}
+
 func (e *Executor) executeLinters(_ *cobra.Command, args []string) {
 	if len(args) != 0 {
 		e.log.Fatalf("Usage: golangci-lint linters")
 	}
 
+	// the targeted Go version depends on go.mod: obsolete linters are listed as disabled
+	if err := e.goenv.Discover(context.Background()); err != nil {
+		e.log.Warnf("Failed to discover go env: %s", err)
+	}
+
 	enabledLCs, err := e.EnabledLintersSet.Get(false)
 	if err != nil {
 		log.Fatalf("Can't get enabled linters: %s", err)
 	}
 
-	color.Green("Enabled by your configuration linters:\n")
-	printLinterConfigs(enabledLCs)
-
 	var disabledLCs []*linter.Config
 	for _, lc := range e.DBManager.GetAllSupportedLinterConfigs() {
 		if !IsLinterInConfigsList(lc.Name(), enabledLCs) {
@@ -50,8 +100,24 @@ func (e *Executor) executeLinters(_ *cobra.Command, args []string) {
 		}
 	}
 
+	if e.cfg.Output.Format == config.OutFormatJSON {
+		res := lintersJSONResult{
+			Enabled:  makeLintersJSONLinters(enabledLCs, e.lintersWithChecks),
+			Disabled: makeLintersJSONLinters(disabledLCs, e.lintersWithChecks),
+		}
+		outputJSON, err := json.Marshal(res)
+		if err != nil {
+			e.log.Fatalf("Can't marshal linters: %s", err)
+		}
+		fmt.Fprint(logutils.StdOut, string(outputJSON))
+		os.Exit(0)
+	}
+
+	color.Green("Enabled by your configuration linters:\n")
+	printLinterConfigs(enabledLCs, e.lintersWithChecks)
+
 	color.Red("\nDisabled by your configuration linters:\n")
-	printLinterConfigs(disabledLCs)
+	printLinterConfigs(disabledLCs, e.lintersWithChecks)
 
 	os.Exit(0)
 }
@@ -3,10 +3,13 @@ package commands
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
 	"runtime"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,10 +20,13 @@ import (
 
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/exitcodes"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/lint"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/printers"
+	"github.com/golangci/golangci-lint/pkg/report"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
@@ -62,25 +68,73 @@ func initFlagSet(fs *pflag.FlagSet, cfg *config.Config, m *lintersdb.Manager, is
 	oc := &cfg.Output
 	fs.StringVar(&oc.Format, "out-format",
 		config.OutFormatColoredLineNumber,
-		wh(fmt.Sprintf("Format of output: %s", strings.Join(config.OutFormats, "|"))))
+		wh(fmt.Sprintf("Format of output: %s; comma-separated formats with optional paths "+
+			"like checkstyle:report.xml,colored-line-number print the same issues to every output "+
+			"(stdout by default, stdout and stderr are special paths)", strings.Join(config.OutFormats, "|"))))
 	fs.BoolVar(&oc.PrintIssuedLine, "print-issued-lines", true, wh("Print lines of code with issue"))
 	fs.BoolVar(&oc.PrintLinterName, "print-linter-name", true, wh("Print linter name in issue line"))
 	fs.BoolVar(&oc.PrintWelcomeMessage, "print-welcome", false, wh("Print welcome message"))
 	hideFlag("print-welcome") // no longer used
+	fs.StringVar(&oc.PathMode, "path-mode", config.PathModeNative,
+		wh(fmt.Sprintf("Mode of issues paths: %s", strings.Join(config.PathModes, "|"))))
+	fs.BoolVar(&oc.TextCollapseRepeats, "text-collapse-repeats", false,
+		wh("Print issues with the same text from the same linter on consecutive lines once in text output"))
+	fs.IntVar(&oc.MaxIssueTextLen, "max-issue-text-len", 0,
+		wh("Maximum length of issues texts in runes in text and tab output: longer ones are truncated. Set to 0 to disable"))
+	fs.BoolVar(&oc.GroupFixable, "group-fixable", false,
+		wh("Print issues which can be fixed by --fix separately from other issues in text output"))
+	fs.StringVar(&oc.FingerprintMode, "fingerprint-mode", config.FingerprintModeLine,
+		wh(fmt.Sprintf("Mode of issues fingerprints in gitlab-sast output and of issues IDs: %s",
+			strings.Join(config.FingerprintModes, "|"))))
+	fs.BoolVar(&oc.PrintIssueID, "print-issue-id", false,
+		wh("Print short stable IDs of issues in text and tab output: they're always in json output"))
+	fs.BoolVar(&oc.StatusJSON, "status-json", false,
+		wh("Print status of the run as JSON to stderr at the end: issues count, exit code, duration and run linters"))
+	fs.StringVar(&oc.IssuesSocket, "issues-socket", "",
+		wh("Stream issues as JSON lines to the socket `unix:PATH` or `tcp:HOST:PORT` in addition to the output"))
+	fs.BoolVar(&oc.MinimalProcessing, "minimal-processing", false,
+		wh("Don't read source lines of issues: faster for tools not needing issued lines, "+
+			"incompatible with --fingerprint-mode=checksum-context"))
+	fs.BoolVar(&oc.Score, "score", false,
+		wh("Print the lint debt score to stderr at the end: the sum of weights of issues set by score-weights in config"))
 
 	// Run config
 	rc := &cfg.Run
 	fs.IntVar(&rc.ExitCodeIfIssuesFound, "issues-exit-code",
 		exitcodes.IssuesFound, wh("Exit code when issues were found"))
+	fs.IntVar(&rc.ExitCodeOnWarning, "exit-code-on-warning", 0,
+		wh("Exit code when only issues with the warning severity were found"))
+	fs.IntVar(&rc.ExitCodeOnError, "exit-code-on-error", 0,
+		wh("Exit code when issues with other severities than warning were found. Set to 0 to use --issues-exit-code"))
 	fs.StringSliceVar(&rc.BuildTags, "build-tags", nil, wh("Build tags"))
 	fs.DurationVar(&rc.Deadline, "deadline", time.Minute, wh("Deadline for total work"))
+	fs.DurationVar(&rc.PackageTimeout, "package-timeout", 0,
+		wh("Timeout of a package in type-aware linters: packages exceeding it are skipped with a warning. Set to 0 to disable"))
 	fs.BoolVar(&rc.AnalyzeTests, "tests", true, wh("Analyze tests (*_test.go)"))
+	fs.BoolVar(&rc.FailFast, "fail-fast", false, wh("Stop running linters and print only the first issue as soon as it was found"))
+	fs.BoolVar(&rc.DryRun, "dry-run", false, wh("Print packages, files and linters which would be analyzed without running linters"))
 	fs.BoolVar(&rc.PrintResourcesUsage, "print-resources-usage", false,
 		wh("Print avg and max memory usage of golangci-lint and total time"))
 	fs.StringVarP(&rc.Config, "config", "c", "", wh("Read config from file path `PATH`"))
 	fs.BoolVar(&rc.NoConfig, "no-config", false, wh("Don't read config"))
 	fs.StringSliceVar(&rc.SkipDirs, "skip-dirs", nil, wh("Regexps of directories to skip"))
 	fs.StringSliceVar(&rc.SkipFiles, "skip-files", nil, wh("Regexps of files to skip"))
+	fs.IntVar(&rc.MaxOpenFiles, "max-open-files", 0,
+		wh("Maximum count of files opened at once to parse them and read issued lines, 0 opens them one by one"))
+	fs.BoolVar(&rc.BestEffortAST, "best-effort-ast", false,
+		wh("Run AST linters on the valid parts of files with syntax errors and report these errors by typecheck"))
+	fs.BoolVar(&rc.StrictConfig, "strict-config", false,
+		wh("Fail on unknown keys in config, e.g. misspelled settings of linters, instead of warning about them"))
+	fs.IntVar(&rc.MaxWalkDepth, "max-walk-depth", 0,
+		wh("Maximum depth of directories walked for recursive (./...) args. Set to 0 to disable the limit"))
+	fs.StringSliceVar(&rc.PruneDirs, "prune-dirs", nil,
+		wh("Regexps of directories to not walk for recursive (./...) args: unlike skip-dirs they aren't loaded at all"))
+	fs.StringVar(&rc.PackagesFromFile, "packages-from-file", "",
+		wh("File with newline-separated import paths or patterns like ./... of packages to lint in addition to args"))
+	fs.StringSliceVar(&rc.SkipMissingPackages, "skip-missing-packages", nil,
+		wh("Regexps of import paths of packages generated by the build: they're skipped instead of failing the run if they have no Go files"))
+	fs.StringVar(&rc.PackageFilter, "package-filter", "",
+		wh("Regexp of import paths of packages to lint: issues of other loaded packages aren't reported"))
 
 	// Linters settings config
 	lsc := &cfg.LintersSettings
@@ -165,6 +219,17 @@ func initFlagSet(fs *pflag.FlagSet, cfg *config.Config, m *lintersdb.Manager, is
 		wh("Maximum issues count per one linter. Set to 0 to disable"))
 	fs.IntVar(&ic.MaxSameIssues, "max-same-issues", 3,
 		wh("Maximum count of issues with the same text. Set to 0 to disable"))
+	fs.BoolVar(&ic.MergeSamePosition, "merge-same-position", false,
+		wh("Merge issues of one linter at the same position into one issue with a multi-line text"))
+	fs.BoolVar(&ic.NolintMostSpecific, "nolint-most-specific", false,
+		wh("When a line is covered by many //nolint directives, let the most specific one (line > func > file) "+
+			"decide, a directive for all linters always wins"))
+	fs.StringVar(&ic.NolintReport, "nolint-report", "",
+		wh(fmt.Sprintf("Print //nolint directives with issues suppressed by them to stderr in the format: %s",
+			strings.Join(config.NolintReportFormats, "|"))))
+	fs.StringVar(&ic.TestSeverity, "test-severity", "",
+		wh(fmt.Sprintf("Severity of all issues in test files, e.g. %s: such issues don't affect the exit code",
+			result.SeverityWarning)))
 
 	fs.BoolVarP(&ic.Diff, "new", "n", false,
 		wh("Show only new issues: if there are unstaged changes or untracked files, only those changes "+
@@ -178,6 +243,13 @@ func initFlagSet(fs *pflag.FlagSet, cfg *config.Config, m *lintersdb.Manager, is
 	fs.StringVar(&ic.DiffPatchFilePath, "new-from-patch", "",
 		wh("Show only new issues created in git patch with file path `PATH`"))
 
+	fs.BoolVar(&ic.NeedFix, "fix", false, wh("Fix found issues (if it's supported by the linter)"))
+	fs.Float64Var(&ic.FixMinConfidence, "fix-min-confidence", 0.8,
+		wh("Minimum confidence of suggested fixes applied by --fix"))
+	fs.BoolVar(&ic.FixCache, "fix-cache", false,
+		wh("Cache issues with suggested fixes: --fix applies them without running linters if analyzed files weren't changed"))
+	fs.StringVar(&ic.MergeReport, "merge-report", "",
+		wh("Merge issues of the report `PATH` in the json output format, e.g. produced by another analyzer, into issues of linters"))
 }
 
 func (e *Executor) initRunConfiguration(cmd *cobra.Command) {
@@ -203,6 +275,10 @@ func (e *Executor) getConfigForCommandLine() (*config.Config, error) {
 	// cfg vs e.cfg.
 	initRootFlagSet(fs, &cfg, true)
 
+	// linters command options aren't stored in config
+	var lintersWithChecks bool
+	initLintersFlagSet(fs, &lintersWithChecks)
+
 	fs.Usage = func() {} // otherwise help text will be printed twice
 	if err := fs.Parse(os.Args); err != nil {
 		if err == pflag.ErrHelp {
@@ -251,9 +327,7 @@ func fixSlicesFlags(fs *pflag.FlagSet) {
 	})
 }
 
-func (e *Executor) runAnalysis(ctx context.Context, args []string) (<-chan result.Issue, error) {
-	e.cfg.Run.Args = args
-
+func (e *Executor) getEnabledLinters() ([]*linter.Config, error) {
 	enabledLinters, err := e.EnabledLintersSet.Get(true)
 	if err != nil {
 		return nil, err
@@ -270,6 +344,17 @@ func (e *Executor) runAnalysis(ctx context.Context, args []string) (<-chan resul
 		e.reportData.AddLinter(lc.Name(), isEnabled, lc.EnabledByDefault)
 	}
 
+	return enabledLinters, nil
+}
+
+func (e *Executor) runAnalysis(ctx context.Context, args []string) (<-chan result.Issue, error) {
+	e.cfg.Run.Args = args
+
+	enabledLinters, err := e.getEnabledLinters()
+	if err != nil {
+		return nil, err
+	}
+
 	lintCtx, err := e.contextLoader.Load(ctx, enabledLinters)
 	if err != nil {
 		return nil, errors.Wrap(err, "context loading failed")
@@ -300,14 +385,58 @@ func (e *Executor) setExitCodeIfIssuesFound(issues <-chan result.Issue) <-chan r
 	resCh := make(chan result.Issue, 1024)
 
 	go func() {
-		issuesFound := false
+		errorsFound, warningsFound := false, false
 		for i := range issues {
-			issuesFound = true
+			e.issuesCount++
+			if i.Severity == result.SeverityWarning {
+				warningsFound = true
+			} else {
+				errorsFound = true
+			}
 			resCh <- i
 		}
 
-		if issuesFound {
+		switch {
+		case errorsFound && e.cfg.Run.ExitCodeOnError != 0:
+			e.exitCode = e.cfg.Run.ExitCodeOnError
+		case errorsFound:
 			e.exitCode = e.cfg.Run.ExitCodeIfIssuesFound
+		case warningsFound && e.cfg.Run.ExitCodeOnWarning != 0:
+			e.exitCode = e.cfg.Run.ExitCodeOnWarning
+		}
+
+		close(resCh)
+	}()
+
+	return resCh
+}
+
+// setIssuesIDs sets IDs of issues by fingerprints: after all processors they're computed by the same data as
+// fingerprints in gitlab-sast output.
+func (e *Executor) setIssuesIDs(issues <-chan result.Issue) <-chan result.Issue {
+	resCh := make(chan result.Issue, 1024)
+
+	go func() {
+		for i := range issues {
+			i.ID = printers.IssueID(&i, e.cfg.Output.FingerprintMode)
+			resCh <- i
+		}
+
+		close(resCh)
+	}()
+
+	return resCh
+}
+
+// computeDebtScore adds issues to the lint debt score printed at the end of the run.
+func (e *Executor) computeDebtScore(issues <-chan result.Issue) <-chan result.Issue {
+	e.debtScore = report.NewDebtScore(e.cfg.Output.ScoreWeights)
+	resCh := make(chan result.Issue, 1024)
+
+	go func() {
+		for i := range issues {
+			e.debtScore.Add(&i)
+			resCh <- i
 		}
 
 		close(resCh)
@@ -330,17 +459,29 @@ func (e *Executor) runAndPrint(ctx context.Context, args []string) error {
 		}()
 	}
 
+	if e.cfg.Run.DryRun {
+		return e.printPlan(ctx, args)
+	}
+
 	issues, err := e.runAnalysis(ctx, args)
 	if err != nil {
 		return err // XXX: don't loose type
 	}
 
-	p, err := e.createPrinter()
+	p, closeOutputs, err := e.createOutputPrinter()
 	if err != nil {
 		return err
 	}
+	defer closeOutputs()
 
+	issues = e.setIssuesIDs(issues)
 	issues = e.setExitCodeIfIssuesFound(issues)
+	if e.cfg.Output.Score {
+		issues = e.computeDebtScore(issues)
+	}
+	if e.cfg.Output.IssuesSocket != "" {
+		issues = e.streamIssuesToSocket(issues, e.cfg.Output.IssuesSocket)
+	}
 
 	if err = p.Print(ctx, issues); err != nil {
 		return fmt.Errorf("can't print %d issues: %s", len(issues), err)
@@ -349,20 +490,117 @@ func (e *Executor) runAndPrint(ctx context.Context, args []string) error {
 	return nil
 }
 
-func (e *Executor) createPrinter() (printers.Printer, error) {
+func (e *Executor) printPlan(ctx context.Context, args []string) error {
+	e.cfg.Run.Args = args
+
+	enabledLinters, err := e.getEnabledLinters()
+	if err != nil {
+		return err
+	}
+
+	plan, err := e.contextLoader.Plan(ctx, enabledLinters)
+	if err != nil {
+		return errors.Wrap(err, "context loading failed")
+	}
+
+	fmt.Fprintf(logutils.StdOut, "Load mode: %s\n", plan.LoadMode)
+	fmt.Fprintf(logutils.StdOut, "Packages (%d):\n", len(plan.Packages))
+	for _, pkg := range plan.Packages {
+		fmt.Fprintf(logutils.StdOut, "  %s\n", pkg.ID)
+		for _, f := range pkg.GoFiles {
+			relPath, err := fsutils.ShortestRelPath(f, "")
+			if err != nil {
+				relPath = f
+			}
+			fmt.Fprintf(logutils.StdOut, "    %s\n", relPath)
+		}
+	}
+
+	var linterNames []string
+	for _, lc := range enabledLinters {
+		linterNames = append(linterNames, lc.Name())
+	}
+	sort.Strings(linterNames)
+
+	fmt.Fprintf(logutils.StdOut, "Linters (%d):\n", len(linterNames))
+	for _, name := range linterNames {
+		fmt.Fprintf(logutils.StdOut, "  %s\n", name)
+	}
+
+	return nil
+}
+
+// createOutputPrinter creates the printer of out formats like checkstyle:report.xml,colored-line-number:
+// issues are processed once and printed by the printer of every format to stdout, stderr or the file.
+func (e *Executor) createOutputPrinter() (printers.Printer, func(), error) {
+	formats := strings.Split(e.cfg.Output.Format, ",")
+	if len(formats) == 1 && !strings.Contains(formats[0], ":") {
+		p, err := e.createPrinter(formats[0], logutils.StdOut)
+		return p, func() {}, err
+	}
+
+	var files []*os.File
+	closeFiles := func() {
+		for _, f := range files {
+			if err := f.Close(); err != nil {
+				e.log.Warnf("Can't close output file %s: %s", f.Name(), err)
+			}
+		}
+	}
+
+	var outputs []printers.Printer
+	for _, outFormat := range formats {
+		format, path := outFormat, "stdout"
+		if idx := strings.Index(outFormat, ":"); idx != -1 {
+			format, path = outFormat[:idx], outFormat[idx+1:]
+		}
+
+		var w io.Writer
+		switch path {
+		case "stdout":
+			w = logutils.StdOut
+		case "stderr":
+			w = logutils.StdErr
+		default:
+			f, err := os.Create(path)
+			if err != nil {
+				closeFiles()
+				return nil, nil, fmt.Errorf("can't create output file for format %s: %s", format, err)
+			}
+			files = append(files, f)
+			w = f
+		}
+
+		p, err := e.createPrinter(format, w)
+		if err != nil {
+			closeFiles()
+			return nil, nil, err
+		}
+		outputs = append(outputs, p)
+	}
+
+	return printers.NewMulti(outputs...), closeFiles, nil
+}
+
+func (e *Executor) createPrinter(format string, w io.Writer) (printers.Printer, error) {
 	var p printers.Printer
-	format := e.cfg.Output.Format
 	switch format {
 	case config.OutFormatJSON:
-		p = printers.NewJSON(&e.reportData)
+		p = printers.NewJSON(&e.reportData, w)
 	case config.OutFormatColoredLineNumber, config.OutFormatLineNumber:
-		p = printers.NewText(e.cfg.Output.PrintIssuedLine,
-			format == config.OutFormatColoredLineNumber, e.cfg.Output.PrintLinterName,
-			e.log.Child("text_printer"))
+		p = printers.NewText(e.textOptions(format), e.log.Child("text_printer"), w)
 	case config.OutFormatTab:
-		p = printers.NewTab(e.cfg.Output.PrintLinterName, e.log.Child("tab_printer"))
+		p = printers.NewTab(e.textOptions(format), e.log.Child("tab_printer"), w)
 	case config.OutFormatCheckstyle:
-		p = printers.NewCheckstyle()
+		p = printers.NewCheckstyle(e.cfg.Output.SeverityMapping[format], w)
+	case config.OutFormatGitLabSAST:
+		var err error
+		p, err = printers.NewGitLabSAST(e.version, e.cfg.Output.FingerprintMode, e.cfg.Output.SeverityMapping[format], w)
+		if err != nil {
+			return nil, err
+		}
+	case config.OutFormatPerFileSummary:
+		p = printers.NewPerFileSummary(e.log.Child("per_file_summary_printer"), w)
 	default:
 		return nil, fmt.Errorf("unknown output format %s", format)
 	}
@@ -370,7 +608,21 @@ func (e *Executor) createPrinter() (printers.Printer, error) {
 	return p, nil
 }
 
+// textOptions returns options of text and tab printers for the output format.
+func (e *Executor) textOptions(format string) printers.TextOptions {
+	return printers.TextOptions{
+		PrintIssuedLine: e.cfg.Output.PrintIssuedLine,
+		UseColors:       format == config.OutFormatColoredLineNumber,
+		PrintLinterName: e.cfg.Output.PrintLinterName,
+		CollapseRepeats: e.cfg.Output.TextCollapseRepeats,
+		GroupFixable:    e.cfg.Output.GroupFixable,
+		PrintIssueID:    e.cfg.Output.PrintIssueID,
+		MaxTextLen:      e.cfg.Output.MaxIssueTextLen,
+	}
+}
+
 func (e *Executor) executeRun(_ *cobra.Command, args []string) {
+	startedAt := time.Now()
 	needTrackResources := e.cfg.Run.IsVerbose || e.cfg.Run.PrintResourcesUsage
 	trackResourcesEndCh := make(chan struct{})
 	defer func() { // XXX: this defer must be before ctx.cancel defer
@@ -398,6 +650,16 @@ func (e *Executor) executeRun(_ *cobra.Command, args []string) {
 	}
 
 	e.setupExitCode(ctx)
+
+	if e.cfg.Output.StatusJSON {
+		if err := printStatusJSON(logutils.StdErr, e.runStatus(time.Since(startedAt))); err != nil {
+			e.log.Errorf("Can't print status: %s", err)
+		}
+	}
+
+	if e.debtScore != nil {
+		fmt.Fprintf(logutils.StdErr, "Lint debt score: %s\n", strconv.FormatFloat(e.debtScore.Value(), 'f', -1, 64))
+	}
 }
 
 func (e *Executor) setupExitCode(ctx context.Context) {
@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// runStatus is printed by --status-json for tools wrapping golangci-lint
+type runStatus struct {
+	IssueCount int      `json:"issue_count"` //nolint:tagliatelle
+	ExitCode   int      `json:"exit_code"`   //nolint:tagliatelle
+	DurationMs int64    `json:"duration_ms"` //nolint:tagliatelle
+	LintersRun []string `json:"linters_run"` //nolint:tagliatelle
+}
+
+func (e *Executor) runStatus(duration time.Duration) runStatus {
+	status := runStatus{
+		IssueCount: e.issuesCount,
+		ExitCode:   e.exitCode,
+		DurationMs: int64(duration / time.Millisecond),
+		LintersRun: []string{},
+	}
+
+	for _, l := range e.reportData.Linters {
+		if l.Enabled {
+			status.LintersRun = append(status.LintersRun, l.Name)
+		}
+	}
+
+	return status
+}
+
+func printStatusJSON(w io.Writer, status runStatus) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
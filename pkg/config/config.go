@@ -10,6 +10,8 @@ const (
 	OutFormatColoredLineNumber = "colored-line-number"
 	OutFormatTab               = "tab"
 	OutFormatCheckstyle        = "checkstyle"
+	OutFormatGitLabSAST        = "gitlab-sast"
+	OutFormatPerFileSummary    = "per-file-summary"
 )
 
 var OutFormats = []string{
@@ -18,6 +20,47 @@ var OutFormats = []string{
 	OutFormatJSON,
 	OutFormatTab,
 	OutFormatCheckstyle,
+	OutFormatGitLabSAST,
+	OutFormatPerFileSummary,
+}
+
+const (
+	PathModeNative = "native"
+	PathModeUnix   = "unix"
+	PathModeAbs    = "abs"
+)
+
+var PathModes = []string{
+	PathModeNative,
+	PathModeUnix,
+	PathModeAbs,
+}
+
+// Fingerprints identify the same issue between runs, e.g. to match issues with a baseline.
+const (
+	// FingerprintModeText uses linter, file and text: the fingerprint survives moving of the code.
+	FingerprintModeText = "text"
+	// FingerprintModeLine uses linter, file, line and text.
+	FingerprintModeLine = "line"
+	// FingerprintModeChecksumContext uses linter, file, text and the source code of the issue lines:
+	// the fingerprint survives moving of the code but not changing of it.
+	FingerprintModeChecksumContext = "checksum-context"
+)
+
+var FingerprintModes = []string{
+	FingerprintModeText,
+	FingerprintModeLine,
+	FingerprintModeChecksumContext,
+}
+
+const (
+	NolintReportText = "text"
+	NolintReportJSON = "json"
+)
+
+var NolintReportFormats = []string{
+	NolintReportText,
+	NolintReportJSON,
 }
 
 type ExcludePattern struct {
@@ -105,14 +148,37 @@ type Run struct {
 
 	BuildTags           []string `mapstructure:"build-tags"`
 	ModulesDownloadMode string   `mapstructure:"modules-download-mode"`
+	Go                  string   `mapstructure:"go"`
 
 	ExitCodeIfIssuesFound int  `mapstructure:"issues-exit-code"`
+	ExitCodeOnWarning     int  `mapstructure:"exit-code-on-warning"`
+	ExitCodeOnError       int  `mapstructure:"exit-code-on-error"`
 	AnalyzeTests          bool `mapstructure:"tests"`
+	FailFast              bool `mapstructure:"fail-fast"`
+	DryRun                bool `mapstructure:"dry-run"`
 	Deadline              time.Duration
+	PackageTimeout        time.Duration `mapstructure:"package-timeout"`
 	PrintVersion          bool
 
 	SkipFiles []string `mapstructure:"skip-files"`
 	SkipDirs  []string `mapstructure:"skip-dirs"`
+
+	MaxOpenFiles int `mapstructure:"max-open-files"`
+
+	BestEffortAST bool `mapstructure:"best-effort-ast"`
+
+	StrictConfig bool `mapstructure:"strict-config"`
+
+	MaxWalkDepth int      `mapstructure:"max-walk-depth"`
+	PruneDirs    []string `mapstructure:"prune-dirs"`
+
+	PackagesFromFile    string   `mapstructure:"packages-from-file"`
+	SkipMissingPackages []string `mapstructure:"skip-missing-packages"`
+	PackageFilter       string   `mapstructure:"package-filter"`
+
+	// Overlay maps absolute file paths to contents analyzed instead of contents on disk,
+	// e.g. to analyze unsaved editor buffers. It can be set only by API users.
+	Overlay map[string][]byte `mapstructure:"-"`
 }
 
 type LintersSettings struct {
@@ -165,6 +231,34 @@ type LintersSettings struct {
 	Prealloc PreallocSettings
 	Errcheck ErrcheckSettings
 	Gocritic GocriticSettings
+
+	Usestdlibvars  UsestdlibvarsSettings
+	Reassign       ReassignSettings
+	Gosmopolitan   GosmopolitanSettings
+	Errorlint      ErrorlintSettings
+	Maintidx       MaintidxSettings
+	Gosec          GosecSettings
+	Nolintlint     NolintlintSettings
+	Decorder       DecorderSettings
+	Cyclop         CyclopSettings
+	Tagliatelle    TagliatelleSettings
+	Mnd            MndSettings
+	Paralleltest   ParalleltestSettings
+	Testpackage    TestpackageSettings
+	Gocognit       GocognitSettings
+	Musttag        MusttagSettings
+	Bidichk        BidichkSettings
+	Wrapcheck      WrapcheckSettings
+	Goerr113       Goerr113Settings
+	Nilnil         NilnilSettings
+	Dupword        DupwordSettings
+	Varnamelen     VarnamelenSettings
+	Importas       ImportasSettings
+	Gochecksumtype GochecksumtypeSettings
+	Loggercheck    LoggercheckSettings
+
+	// MessagePrefixes are set by the message-prefix option of any linter: linter name to prefix
+	MessagePrefixes map[string]string `mapstructure:"-"`
 }
 
 type ErrcheckSettings struct {
@@ -194,6 +288,138 @@ type PreallocSettings struct {
 	ForLoops   bool `mapstructure:"for-loops"`
 }
 
+type UsestdlibvarsSettings struct {
+	HTTPMethod     bool `mapstructure:"http-method"`
+	HTTPStatusCode bool `mapstructure:"http-status-code"`
+	TimeWeekday    bool `mapstructure:"time-weekday"`
+	TimeMonth      bool `mapstructure:"time-month"`
+}
+
+type ReassignSettings struct {
+	Patterns []string
+}
+
+type GosmopolitanSettings struct {
+	WatchForScripts []string `mapstructure:"watch-for-scripts"`
+	AllowTimeLocal  bool     `mapstructure:"allow-time-local"`
+}
+
+type ErrorlintSettings struct {
+	Errorf     bool
+	Asserts    bool
+	Comparison bool
+}
+
+type MaintidxSettings struct {
+	Under int
+}
+
+type GosecSettings struct {
+	Severity   string
+	Confidence string
+}
+
+type NolintlintSettings struct {
+	RequireExplanation bool `mapstructure:"require-explanation"`
+	RequireSpecific    bool `mapstructure:"require-specific"`
+	AllowLeadingSpace  bool `mapstructure:"allow-leading-space"`
+}
+
+type DecorderSettings struct {
+	DecOrder    []string `mapstructure:"dec-order"`
+	SingleConst bool     `mapstructure:"single-const"`
+	SingleVar   bool     `mapstructure:"single-var"`
+}
+
+type CyclopSettings struct {
+	MaxComplexity  int     `mapstructure:"max-complexity"`
+	PackageAverage float64 `mapstructure:"package-average"`
+}
+
+type TagliatelleSettings struct {
+	Rules map[string]string // tag key to case
+}
+
+type MndSettings struct {
+	Checks         []string
+	IgnoredNumbers []string `mapstructure:"ignored-numbers"`
+}
+
+type ParalleltestSettings struct {
+	IgnoreMissing bool `mapstructure:"ignore-missing"`
+}
+
+type GocognitSettings struct {
+	MinComplexity int `mapstructure:"min-complexity"`
+}
+
+type MusttagSettings struct {
+	Functions []MusttagFunction
+}
+
+// MusttagFunction is a function serializing its argument, e.g. encoding/json.Marshal
+// or a method like (*encoding/json.Encoder).Encode
+type MusttagFunction struct {
+	Name   string
+	Tag    string
+	ArgPos int `mapstructure:"arg-pos"`
+}
+
+type BidichkSettings struct {
+	DisallowedRunes []string `mapstructure:"disallowed-runes"`
+}
+
+type WrapcheckSettings struct {
+	IgnoreSigs         []string `mapstructure:"ignore-sigs"`
+	IgnorePackageGlobs []string `mapstructure:"ignore-package-globs"`
+}
+
+type Goerr113Settings struct {
+	DynamicErrors bool `mapstructure:"dynamic-errors"`
+	Comparison    bool
+}
+
+type NilnilSettings struct {
+	CheckedTypes []string `mapstructure:"checked-types"`
+}
+
+type DupwordSettings struct {
+	// Ignore are words allowed to be duplicated, e.g. "that"
+	Ignore []string
+}
+
+type VarnamelenSettings struct {
+	MaxDistance   int      `mapstructure:"max-distance"`
+	MinNameLength int      `mapstructure:"min-name-length"`
+	IgnoreNames   []string `mapstructure:"ignore-names"`
+}
+
+type ImportasSettings struct {
+	NoUnaliased bool `mapstructure:"no-unaliased"`
+	Alias       []ImportasAlias
+}
+
+// ImportasAlias requires the alias for imports matching the Pkg regexp: it can reference submatches,
+// e.g. $1$2 for k8s.io/api/(\w+)/(v\d+)
+type ImportasAlias struct {
+	Pkg   string
+	Alias string
+}
+
+type GochecksumtypeSettings struct {
+	DefaultSignifiesExhaustive bool `mapstructure:"default-signifies-exhaustive"`
+}
+
+// LoggercheckSettings are full names of checked logging functions and methods, e.g. log/slog.Info or
+// (*log/slog.Logger).Info: their variadic arguments are key-value pairs.
+type LoggercheckSettings struct {
+	Funcs []string
+}
+
+type TestpackageSettings struct {
+	SkipRegexp string `mapstructure:"skip-regexp"`
+}
+
 var defaultLintersSettings = LintersSettings{
 	Lll: LllSettings{
 		LineLength: 120,
@@ -213,6 +439,115 @@ var defaultLintersSettings = LintersSettings{
 	Gocritic: GocriticSettings{
 		SettingsPerCheck: map[string]GocriticCheckSettings{},
 	},
+	Usestdlibvars: UsestdlibvarsSettings{
+		HTTPMethod:     true,
+		HTTPStatusCode: true,
+		TimeWeekday:    true,
+		TimeMonth:      false,
+	},
+	Reassign: ReassignSettings{
+		Patterns: []string{"EOF", "Err.*"},
+	},
+	Gosmopolitan: GosmopolitanSettings{
+		WatchForScripts: []string{"Han"},
+	},
+	Errorlint: ErrorlintSettings{
+		Errorf:     true,
+		Asserts:    true,
+		Comparison: true,
+	},
+	Maintidx: MaintidxSettings{
+		Under: 20,
+	},
+	Gosec: GosecSettings{
+		Severity:   "low",
+		Confidence: "low",
+	},
+	Nolintlint: NolintlintSettings{
+		AllowLeadingSpace: true,
+	},
+	Decorder: DecorderSettings{
+		DecOrder: []string{"type", "const", "var", "func"},
+	},
+	Cyclop: CyclopSettings{
+		MaxComplexity: 10,
+	},
+	Tagliatelle: TagliatelleSettings{
+		Rules: map[string]string{
+			"json":         "camel",
+			"yaml":         "camel",
+			"xml":          "camel",
+			"mapstructure": "kebab",
+		},
+	},
+	Mnd: MndSettings{
+		Checks:         []string{"argument", "assign", "case", "condition", "operation", "return"},
+		IgnoredNumbers: []string{"0", "1"},
+	},
+	Gocognit: GocognitSettings{
+		MinComplexity: 30,
+	},
+	Testpackage: TestpackageSettings{
+		SkipRegexp: `(export|internal)_test\.go`,
+	},
+	Bidichk: BidichkSettings{
+		DisallowedRunes: []string{
+			"left-to-right-embedding", "right-to-left-embedding", "pop-directional-formatting",
+			"left-to-right-override", "right-to-left-override",
+			"left-to-right-isolate", "right-to-left-isolate", "first-strong-isolate", "pop-directional-isolate",
+		},
+	},
+	Loggercheck: LoggercheckSettings{
+		Funcs: []string{
+			"log/slog.Debug", "log/slog.Info", "log/slog.Warn", "log/slog.Error",
+			"log/slog.DebugContext", "log/slog.InfoContext", "log/slog.WarnContext", "log/slog.ErrorContext",
+			"log/slog.Log", "log/slog.Group",
+			"(*log/slog.Logger).Debug", "(*log/slog.Logger).Info", "(*log/slog.Logger).Warn", "(*log/slog.Logger).Error",
+			"(*log/slog.Logger).DebugContext", "(*log/slog.Logger).InfoContext",
+			"(*log/slog.Logger).WarnContext", "(*log/slog.Logger).ErrorContext",
+			"(*log/slog.Logger).Log", "(*log/slog.Logger).With",
+			"(*go.uber.org/zap.SugaredLogger).Debugw", "(*go.uber.org/zap.SugaredLogger).Infow",
+			"(*go.uber.org/zap.SugaredLogger).Warnw", "(*go.uber.org/zap.SugaredLogger).Errorw",
+			"(*go.uber.org/zap.SugaredLogger).DPanicw", "(*go.uber.org/zap.SugaredLogger).Panicw",
+			"(*go.uber.org/zap.SugaredLogger).Fatalw", "(*go.uber.org/zap.SugaredLogger).With",
+		},
+	},
+	Gochecksumtype: GochecksumtypeSettings{
+		DefaultSignifiesExhaustive: true,
+	},
+	Varnamelen: VarnamelenSettings{
+		MaxDistance:   5,
+		MinNameLength: 3,
+	},
+	Nilnil: NilnilSettings{
+		CheckedTypes: []string{"ptr", "func", "iface", "map", "chan"},
+	},
+	Goerr113: Goerr113Settings{
+		DynamicErrors: true,
+		Comparison:    true,
+	},
+	Wrapcheck: WrapcheckSettings{
+		IgnoreSigs: []string{
+			".Errorf(", "errors.New(", "errors.Unwrap(", "errors.Join(",
+			".Wrap(", ".Wrapf(", ".WithMessage(", ".WithMessagef(", ".WithStack(",
+		},
+	},
+	Musttag: MusttagSettings{
+		Functions: []MusttagFunction{
+			{Name: "encoding/json.Marshal", Tag: "json"},
+			{Name: "encoding/json.MarshalIndent", Tag: "json"},
+			{Name: "encoding/json.Unmarshal", Tag: "json", ArgPos: 1},
+			{Name: "(*encoding/json.Encoder).Encode", Tag: "json"},
+			{Name: "(*encoding/json.Decoder).Decode", Tag: "json"},
+			{Name: "encoding/xml.Marshal", Tag: "xml"},
+			{Name: "encoding/xml.MarshalIndent", Tag: "xml"},
+			{Name: "encoding/xml.Unmarshal", Tag: "xml", ArgPos: 1},
+			{Name: "gopkg.in/yaml.v2.Marshal", Tag: "yaml"},
+			{Name: "gopkg.in/yaml.v2.Unmarshal", Tag: "yaml", ArgPos: 1},
+			{Name: "gopkg.in/yaml.v3.Marshal", Tag: "yaml"},
+			{Name: "gopkg.in/yaml.v3.Unmarshal", Tag: "yaml", ArgPos: 1},
+		},
+	},
 }
 
 type Linters struct {
@@ -223,18 +558,53 @@ type Linters struct {
 	Fast       bool
 
 	Presets []string
+
+	Overrides []LintersOverride
+}
+
+// LintersOverride disables linters for files matching the path regexp: their issues in these files
+// are dropped. Enable re-enables linters disabled by previous overrides, e.g. for a subdirectory:
+// linters must be enabled by the above options to run.
+type LintersOverride struct {
+	Path    string
+	Enable  []string
+	Disable []string
 }
 
 type Issues struct {
 	ExcludePatterns    []string `mapstructure:"exclude"`
 	UseDefaultExcludes bool     `mapstructure:"exclude-use-default"`
 
+	ExcludeRules         []ExcludeRule            `mapstructure:"exclude-rules"`
+	ExcludeRuleSets      map[string][]ExcludeRule `mapstructure:"exclude-rule-sets"`
+	ExcludeRuleSetsFiles []string                 `mapstructure:"exclude-rule-sets-files"`
+
 	MaxIssuesPerLinter int `mapstructure:"max-issues-per-linter"`
 	MaxSameIssues      int `mapstructure:"max-same-issues"`
 
+	MergeSamePosition bool `mapstructure:"merge-same-position"`
+
+	NolintMostSpecific bool   `mapstructure:"nolint-most-specific"`
+	NolintReport       string `mapstructure:"nolint-report"`
+
+	TestSeverity string `mapstructure:"test-severity"`
+
 	DiffFromRevision  string `mapstructure:"new-from-rev"`
 	DiffPatchFilePath string `mapstructure:"new-from-patch"`
 	Diff              bool   `mapstructure:"new"`
+
+	NeedFix          bool    `mapstructure:"fix"`
+	FixMinConfidence float64 `mapstructure:"fix-min-confidence"`
+	FixCache         bool    `mapstructure:"fix-cache"`
+
+	MergeReport string `mapstructure:"merge-report"`
+}
+
+// ScoreWeights are weights of issues in the lint debt score by severities and linters names:
+// the weight of an issue is the product of them, missing weights are 1.
+type ScoreWeights struct {
+	Severities map[string]float64
+	Linters    map[string]float64
 }
 
 type Config struct { //nolint:maligned
@@ -242,9 +612,23 @@ type Config struct { //nolint:maligned
 
 	Output struct {
 		Format              string
-		PrintIssuedLine     bool `mapstructure:"print-issued-lines"`
-		PrintLinterName     bool `mapstructure:"print-linter-name"`
-		PrintWelcomeMessage bool `mapstructure:"print-welcome"`
+		PrintIssuedLine     bool         `mapstructure:"print-issued-lines"`
+		PrintLinterName     bool         `mapstructure:"print-linter-name"`
+		PrintWelcomeMessage bool         `mapstructure:"print-welcome"`
+		PathMode            string       `mapstructure:"path-mode"`
+		TextCollapseRepeats bool         `mapstructure:"text-collapse-repeats"`
+		FingerprintMode     string       `mapstructure:"fingerprint-mode"`
+		PrintIssueID        bool         `mapstructure:"print-issue-id"`
+		GroupFixable        bool         `mapstructure:"group-fixable"`
+		MaxIssueTextLen     int          `mapstructure:"max-issue-text-len"`
+		StatusJSON          bool         `mapstructure:"status-json"`
+		IssuesSocket        string       `mapstructure:"issues-socket"`
+		MinimalProcessing   bool         `mapstructure:"minimal-processing"`
+		Score               bool         `mapstructure:"score"`
+		ScoreWeights        ScoreWeights `mapstructure:"score-weights"`
+
+		// SeverityMapping maps severities of issues to levels of the output format by format name
+		SeverityMapping map[string]map[string]string `mapstructure:"severity-mapping"`
 	}
 
 	LintersSettings LintersSettings `mapstructure:"linters-settings"`
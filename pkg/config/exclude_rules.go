@@ -0,0 +1,107 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// ExcludeRule excludes issues matching all of its non-empty fields.
+// A rule can also include rules of named sets by Extends.
+type ExcludeRule struct {
+	Linters []string
+	Path    string
+	Text    string
+
+	// Kind lists kinds of statements at the issue position, e.g. defer, go or assign
+	Kind []string
+
+	Extends []string
+}
+
+func (r ExcludeRule) hasMatchers() bool {
+	return len(r.Linters) != 0 || r.Path != "" || r.Text != "" || len(r.Kind) != 0
+}
+
+// LoadExcludeRuleSets merges sets defined inline with sets from files. Files can be shared
+// between projects: they have the same exclude-rule-sets section as the config file.
+// Relative paths of files are relative to baseDir.
+func LoadExcludeRuleSets(inlineSets map[string][]ExcludeRule, files []string, baseDir string) (map[string][]ExcludeRule, error) {
+	sets := map[string][]ExcludeRule{}
+	for name, rules := range inlineSets {
+		sets[name] = rules
+	}
+
+	for _, f := range files {
+		if !filepath.IsAbs(f) {
+			f = filepath.Join(baseDir, f)
+		}
+
+		v := viper.New()
+		v.SetConfigFile(f)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("can't read exclude rule sets file %s: %s", f, err)
+		}
+
+		var fileCfg struct {
+			ExcludeRuleSets map[string][]ExcludeRule `mapstructure:"exclude-rule-sets"`
+		}
+		if err := v.Unmarshal(&fileCfg); err != nil {
+			return nil, fmt.Errorf("can't unmarshal exclude rule sets file %s: %s", f, err)
+		}
+
+		for name, rules := range fileCfg.ExcludeRuleSets {
+			if _, ok := sets[name]; ok {
+				return nil, fmt.Errorf("exclude rule set %q from %s is already defined", name, f)
+			}
+			sets[name] = rules
+		}
+	}
+
+	return sets, nil
+}
+
+// ResolveExcludeRules replaces references to named sets by rules of these sets.
+func ResolveExcludeRules(rules []ExcludeRule, sets map[string][]ExcludeRule) ([]ExcludeRule, error) {
+	var ret []ExcludeRule
+	if err := resolveExcludeRules(rules, sets, nil, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func resolveExcludeRules(rules []ExcludeRule, sets map[string][]ExcludeRule, stack []string, ret *[]ExcludeRule) error {
+	for _, r := range rules {
+		if !r.hasMatchers() && len(r.Extends) == 0 {
+			return fmt.Errorf("exclude rule must have at least one of linters, path, text, kind or extends")
+		}
+
+		if r.hasMatchers() {
+			rule := r
+			rule.Extends = nil
+			*ret = append(*ret, rule)
+		}
+
+		for _, name := range r.Extends {
+			for _, s := range stack {
+				if s == name {
+					return fmt.Errorf("exclude rule sets extend each other: %s -> %s", strings.Join(stack, " -> "), name)
+				}
+			}
+
+			setRules, ok := sets[name]
+			if !ok {
+				return fmt.Errorf("undefined exclude rule set %q", name)
+			}
+
+			if err := resolveExcludeRules(setRules, sets, append(stack, name), ret); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
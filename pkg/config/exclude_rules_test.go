@@ -0,0 +1,66 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveExcludeRulesExtends(t *testing.T) {
+	sets := map[string][]ExcludeRule{
+		"no-test-errcheck": {{Path: `_test\.go`, Linters: []string{"errcheck"}}},
+		"generated":        {{Path: `\.pb\.go`}, {Extends: []string{"no-test-errcheck"}}},
+	}
+
+	rules, err := ResolveExcludeRules([]ExcludeRule{
+		{Text: "G104", Extends: []string{"generated"}},
+	}, sets)
+	require.NoError(t, err)
+	assert.Equal(t, []ExcludeRule{
+		{Text: "G104"},
+		{Path: `\.pb\.go`},
+		{Path: `_test\.go`, Linters: []string{"errcheck"}},
+	}, rules)
+}
+
+func TestResolveExcludeRulesErrors(t *testing.T) {
+	_, err := ResolveExcludeRules([]ExcludeRule{{Extends: []string{"undefined"}}}, nil)
+	assert.EqualError(t, err, `undefined exclude rule set "undefined"`)
+
+	_, err = ResolveExcludeRules([]ExcludeRule{{Extends: []string{"a"}}}, map[string][]ExcludeRule{
+		"a": {{Extends: []string{"b"}}},
+		"b": {{Extends: []string{"a"}}},
+	})
+	assert.EqualError(t, err, "exclude rule sets extend each other: a -> b -> a")
+
+	_, err = ResolveExcludeRules([]ExcludeRule{{}}, nil)
+	assert.Error(t, err)
+}
+
+func TestLoadExcludeRuleSetsFromFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "golangci_exclude_rules_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	content := "exclude-rule-sets:\n" +
+		"  no-test-errcheck:\n" +
+		"    - path: _test\\.go\n" +
+		"      linters:\n" +
+		"        - errcheck\n"
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "shared.yml"), []byte(content), os.ModePerm))
+
+	inline := map[string][]ExcludeRule{"local": {{Text: "local"}}}
+	sets, err := LoadExcludeRuleSets(inline, []string{"shared.yml"}, dir)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]ExcludeRule{
+		"local":            {{Text: "local"}},
+		"no-test-errcheck": {{Path: `_test\.go`, Linters: []string{"errcheck"}}},
+	}, sets)
+
+	_, err = LoadExcludeRuleSets(map[string][]ExcludeRule{"no-test-errcheck": nil}, []string{"shared.yml"}, dir)
+	assert.Error(t, err)
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 
 	"github.com/golangci/golangci-lint/pkg/fsutils"
@@ -75,6 +77,12 @@ func (r *FileReader) parseConfig() error {
 	if err := viper.Unmarshal(r.cfg); err != nil {
 		return fmt.Errorf("can't unmarshal config by viper: %s", err)
 	}
+	r.cfg.Run.Config = viper.ConfigFileUsed() // files like .golangciignore are searched near it
+	r.cfg.LintersSettings.MessagePrefixes = readMessagePrefixes(viper.GetStringMap("linters-settings"))
+
+	if err := r.checkUnknownKeys(viper.AllSettings()); err != nil {
+		return err
+	}
 
 	if err := r.validateConfig(); err != nil {
 		return fmt.Errorf("can't validate config: %s", err)
@@ -88,6 +96,81 @@ func (r *FileReader) parseConfig() error {
 	return nil
 }
 
+// readMessagePrefixes reads the message-prefix option which every linter has: it's not
+// in the settings structs of linters.
+func readMessagePrefixes(lintersSettings map[string]interface{}) map[string]string {
+	prefixes := map[string]string{}
+	for name, settings := range lintersSettings {
+		settingsMap, ok := settings.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if prefix, ok := settingsMap["message-prefix"].(string); ok && prefix != "" {
+			prefixes[name] = prefix
+		}
+	}
+
+	return prefixes
+}
+
+// checkUnknownKeys reports keys of the config which aren't options, e.g. misspelled ones:
+// they're errors with --strict-config and warnings otherwise.
+func (r *FileReader) checkUnknownKeys(settings map[string]interface{}) error {
+	unknownKeys, err := findUnknownKeys(settings)
+	if err != nil {
+		return fmt.Errorf("can't find unknown keys in config: %s", err)
+	}
+	if len(unknownKeys) == 0 {
+		return nil
+	}
+
+	strict := r.cfg.Run.StrictConfig || (r.commandLineCfg != nil && r.commandLineCfg.Run.StrictConfig)
+	if strict {
+		return fmt.Errorf("unknown keys in config: %s", strings.Join(unknownKeys, ", "))
+	}
+
+	r.log.Warnf("Unknown keys in config are ignored: %s", strings.Join(unknownKeys, ", "))
+	return nil
+}
+
+// findUnknownKeys decodes settings like viper does and returns keys which weren't decoded into Config.
+func findUnknownKeys(settings map[string]interface{}) ([]string, error) {
+	var md mapstructure.Metadata
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Metadata:         &md,
+		Result:           &Config{},
+		WeaklyTypedInput: true,
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			mapstructure.StringToTimeDurationHookFunc(),
+			mapstructure.StringToSliceHookFunc(","),
+		),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decoder.Decode(settings); err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, key := range md.Unused {
+		key = strings.ToLower(key) // names of fields without tags are Go names
+		parts := strings.Split(key, ".")
+		if parts[0] == "service" {
+			continue // settings of golangci.com
+		}
+		if len(parts) == 3 && parts[0] == "linters-settings" && parts[2] == "message-prefix" {
+			continue // it's read by readMessagePrefixes
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
 func (r *FileReader) validateConfig() error {
 	c := r.cfg
 	if len(c.Run.Args) != 0 {
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func TestFindUnknownKeys(t *testing.T) {
+	keys, err := findUnknownKeys(map[string]interface{}{
+		"linters-settings": map[string]interface{}{
+			"lll": map[string]interface{}{
+				"line-length":    100,
+				"message-prefix": "lll: ",
+				"line-lenght":    120,
+			},
+		},
+		"issues":  map[string]interface{}{"max-per-linter": 0},
+		"service": map[string]interface{}{"golangci-lint-version": "1.13.x"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"issues.max-per-linter", "linters-settings.lll.line-lenght"}, keys)
+}
+
+func TestCheckUnknownKeys(t *testing.T) {
+	settings := map[string]interface{}{
+		"linters-settings": map[string]interface{}{
+			"gocyclo": map[string]interface{}{"min-complexit": 20},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log := logutils.NewMockLog(ctrl)
+	log.EXPECT().Warnf("Unknown keys in config are ignored: %s", "linters-settings.gocyclo.min-complexit")
+	r := NewFileReader(NewDefault(), &Config{}, log)
+	assert.NoError(t, r.checkUnknownKeys(settings))
+
+	strictCfg := &Config{}
+	strictCfg.Run.StrictConfig = true
+	r = NewFileReader(NewDefault(), strictCfg, logutils.NewMockLog(ctrl))
+	err := r.checkUnknownKeys(settings)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "linters-settings.gocyclo.min-complexit")
+}
@@ -0,0 +1,77 @@
+package fsutils
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// FindGoDirs walks root and returns directories containing Go files like
+// `go list root/...` does: directories starting with . or _, testdata
+// directories, vendor directories and nested modules aren't walked.
+// Also it doesn't walk directories deeper than maxDepth levels below root
+// if maxDepth > 0 and directories which path relative to root matches any of prune.
+func FindGoDirs(root string, maxDepth int, prune []*regexp.Regexp) ([]string, error) {
+	var dirs []string
+	seenDirs := map[string]bool{}
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() {
+			dir := filepath.Dir(path)
+			if strings.HasSuffix(path, ".go") && !seenDirs[dir] {
+				seenDirs[dir] = true
+				dirs = append(dirs, dir)
+			}
+			return nil
+		}
+
+		if path == root {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		if shouldPruneDir(path, relPath, maxDepth, prune) {
+			return filepath.SkipDir
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(dirs)
+	return dirs, nil
+}
+
+func shouldPruneDir(path, relPath string, maxDepth int, prune []*regexp.Regexp) bool {
+	base := filepath.Base(path)
+	if strings.HasPrefix(base, ".") || strings.HasPrefix(base, "_") || base == "testdata" || base == "vendor" {
+		return true
+	}
+
+	if maxDepth > 0 && strings.Count(relPath, string(filepath.Separator))+1 > maxDepth {
+		return true
+	}
+
+	for _, p := range prune {
+		if p.MatchString(filepath.ToSlash(relPath)) {
+			return true
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(path, "go.mod")); err == nil {
+		return true
+	}
+
+	return false
+}
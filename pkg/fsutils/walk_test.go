@@ -0,0 +1,72 @@
+package fsutils
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeWalkTree(t *testing.T, dirs ...string) string {
+	root, err := ioutil.TempDir("", "walk")
+	require.NoError(t, err)
+
+	for _, dir := range dirs {
+		path := filepath.Join(root, filepath.FromSlash(dir))
+		require.NoError(t, os.MkdirAll(path, os.ModePerm))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(path, "f.go"), []byte("package p\n"), os.ModePerm))
+	}
+
+	return root
+}
+
+func relDirs(t *testing.T, root string, dirs []string) []string {
+	var ret []string
+	for _, dir := range dirs {
+		rel, err := filepath.Rel(root, dir)
+		require.NoError(t, err)
+		ret = append(ret, filepath.ToSlash(rel))
+	}
+	return ret
+}
+
+func TestFindGoDirs(t *testing.T) {
+	root := makeWalkTree(t, ".", "a", "a/b", ".hidden", "_skip", "testdata", "vendor/v")
+	defer os.RemoveAll(root)
+
+	dirs, err := FindGoDirs(root, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{".", "a", "a/b"}, relDirs(t, root, dirs))
+}
+
+func TestFindGoDirsMaxDepth(t *testing.T) {
+	root := makeWalkTree(t, ".", "a", "a/b", "a/b/c")
+	defer os.RemoveAll(root)
+
+	dirs, err := FindGoDirs(root, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{".", "a"}, relDirs(t, root, dirs))
+}
+
+func TestFindGoDirsPrune(t *testing.T) {
+	root := makeWalkTree(t, ".", "a", "a/b", "data/big", "data2")
+	defer os.RemoveAll(root)
+
+	dirs, err := FindGoDirs(root, 0, []*regexp.Regexp{regexp.MustCompile(`^data$`), regexp.MustCompile(`/b$`)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{".", "a", "data2"}, relDirs(t, root, dirs))
+}
+
+func TestFindGoDirsNestedModule(t *testing.T) {
+	root := makeWalkTree(t, ".", "a", "mod")
+	defer os.RemoveAll(root)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(root, "mod", "go.mod"), []byte("module mod\n"), os.ModePerm))
+
+	dirs, err := FindGoDirs(root, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{".", "a"}, relDirs(t, root, dirs))
+}
@@ -0,0 +1,87 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/token"
+	"io/ioutil"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Bidichk struct{}
+
+func (Bidichk) Name() string {
+	return "bidichk"
+}
+
+func (Bidichk) Desc() string {
+	return "Checks for dangerous unicode character sequences"
+}
+
+// bidiRunes are unicode bidirectional control characters by names used in the config:
+// they change the displayed order of code, e.g. in the Trojan Source attack.
+var bidiRunes = map[string]rune{
+	"left-to-right-embedding":    '\u202A',
+	"right-to-left-embedding":    '\u202B',
+	"pop-directional-formatting": '\u202C',
+	"left-to-right-override":     '\u202D',
+	"right-to-left-override":     '\u202E',
+	"left-to-right-isolate":      '\u2066',
+	"right-to-left-isolate":      '\u2067',
+	"first-strong-isolate":       '\u2068',
+	"pop-directional-isolate":    '\u2069',
+}
+
+func (lint Bidichk) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	disallowed := map[rune]string{}
+	for _, name := range lintCtx.Settings().Bidichk.DisallowedRunes {
+		r, ok := bidiRunes[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown bidirectional character %q", name)
+		}
+		disallowed[r] = strings.ToUpper(name)
+	}
+
+	var res []result.Issue
+	for _, f := range getAllFileNames(lintCtx) {
+		content, err := ioutil.ReadFile(f)
+		if err != nil {
+			return nil, fmt.Errorf("can't read file %s: %s", f, err)
+		}
+		res = append(res, lint.checkContent(f, content, disallowed)...)
+	}
+
+	return res, nil
+}
+
+func (lint Bidichk) checkContent(filename string, content []byte, disallowed map[rune]string) []result.Issue {
+	var res []result.Issue
+	line, lineStart := 1, 0
+	for offset := 0; offset < len(content); {
+		r, size := utf8.DecodeRune(content[offset:])
+		if name, ok := disallowed[r]; ok {
+			res = append(res, result.Issue{
+				Pos: token.Position{
+					Filename: filename,
+					Offset:   offset,
+					Line:     line,
+					Column:   offset - lineStart + 1,
+				},
+				Text:       fmt.Sprintf("found dangerous unicode character sequence %s", name),
+				FromLinter: lint.Name(),
+			})
+		}
+
+		offset += size
+		if r == '\n' {
+			line++
+			lineStart = offset
+		}
+	}
+
+	return res
+}
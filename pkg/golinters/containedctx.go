@@ -0,0 +1,57 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Containedctx struct{}
+
+func (Containedctx) Name() string {
+	return "containedctx"
+}
+
+func (Containedctx) Desc() string {
+	return "Detects struct types with a context.Context field"
+}
+
+func (lint Containedctx) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		contextPkg := getImportName(f.F, "context")
+		if contextPkg == "" {
+			continue
+		}
+
+		ast.Inspect(f.F, func(node ast.Node) bool {
+			st, ok := node.(*ast.StructType)
+			if !ok {
+				return true
+			}
+
+			for _, field := range st.Fields.List {
+				if !isPkgSelector(field.Type, contextPkg, "Context") {
+					continue
+				}
+
+				name := "embedded"
+				if len(field.Names) != 0 {
+					name = formatCode(field.Names[0].Name, lintCtx.Cfg)
+				}
+				res = append(res, result.Issue{
+					Pos: f.Fset.Position(field.Pos()),
+					Text: fmt.Sprintf("found a struct that contains a context.Context field %s: "+
+						"pass the context as a parameter instead", name),
+					FromLinter: lint.Name(),
+				})
+			}
+			return true
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,84 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	gocycloAPI "github.com/golangci/gocyclo/pkg/gocyclo"
+
+	"github.com/golangci/golangci-lint/pkg/lint/astcache"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Cyclop struct{}
+
+func (Cyclop) Name() string {
+	return "cyclop"
+}
+
+func (Cyclop) Desc() string {
+	return "Checks the cyclomatic complexity of functions and the average complexity of packages"
+}
+
+type cyclopPackage struct {
+	firstFile *astcache.File
+	stats     []gocycloAPI.Stat
+}
+
+func (c Cyclop) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	settings := &lintCtx.Settings().Cyclop
+
+	// files of one directory can belong to two packages: pkg and pkg_test
+	pkgs := map[string]*cyclopPackage{}
+	var pkgKeys []string
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		key := filepath.Dir(f.Name) + ":" + f.F.Name.Name
+		pkg := pkgs[key]
+		if pkg == nil {
+			pkg = &cyclopPackage{firstFile: f}
+			pkgs[key] = pkg
+			pkgKeys = append(pkgKeys, key)
+		} else if f.Name < pkg.firstFile.Name {
+			pkg.firstFile = f
+		}
+		pkg.stats = gocycloAPI.BuildStats(f.F, f.Fset, pkg.stats)
+	}
+	sort.Strings(pkgKeys)
+
+	var res []result.Issue
+	for _, key := range pkgKeys {
+		pkg := pkgs[key]
+		if len(pkg.stats) == 0 {
+			continue
+		}
+
+		totalComplexity := 0
+		for _, s := range pkg.stats {
+			totalComplexity += s.Complexity
+			if settings.MaxComplexity > 0 && s.Complexity > settings.MaxComplexity {
+				res = append(res, result.Issue{
+					Pos: s.Pos,
+					Text: fmt.Sprintf("calculated cyclomatic complexity for function %s is %d, max is %d",
+						formatCode(s.FuncName, lintCtx.Cfg), s.Complexity, settings.MaxComplexity),
+					FromLinter: c.Name(),
+				})
+			}
+		}
+
+		avg := float64(totalComplexity) / float64(len(pkg.stats))
+		if settings.PackageAverage > 0 && avg > settings.PackageAverage {
+			f := pkg.firstFile
+			res = append(res, result.Issue{
+				Pos: f.Fset.Position(f.F.Package),
+				Text: fmt.Sprintf("the average complexity for the package %s is %.2f, max is %.2f",
+					formatCode(f.F.Name.Name, lintCtx.Cfg), avg, settings.PackageAverage),
+				FromLinter: c.Name(),
+			})
+		}
+	}
+
+	return res, nil
+}
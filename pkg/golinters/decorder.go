@@ -0,0 +1,110 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Decorder struct{}
+
+func (Decorder) Name() string {
+	return "decorder"
+}
+
+func (Decorder) Desc() string {
+	return "Checks declaration order and count of types, constants, variables and functions"
+}
+
+var decorderKinds = []string{"type", "const", "var", "func"}
+
+func (lint Decorder) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	settings := &lintCtx.Settings().Decorder
+
+	order := map[string]int{}
+	for i, kind := range settings.DecOrder {
+		kind = strings.ToLower(strings.TrimSpace(kind))
+		if !isDecorderKind(kind) {
+			return nil, fmt.Errorf("unknown declaration kind %q in dec-order, only (%s) allowed",
+				kind, strings.Join(decorderKinds, "|"))
+		}
+		order[kind] = i
+	}
+
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		res = append(res, lint.checkFile(f.F, f.Fset, order, settings)...)
+	}
+
+	return res, nil
+}
+
+func isDecorderKind(kind string) bool {
+	for _, k := range decorderKinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+func decorderDeclKind(decl ast.Decl) string {
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		return "func"
+	case *ast.GenDecl:
+		if decl.Tok != token.IMPORT {
+			return decl.Tok.String()
+		}
+	}
+
+	return ""
+}
+
+func (lint Decorder) checkFile(f *ast.File, fset *token.FileSet, order map[string]int,
+	settings *config.DecorderSettings) []result.Issue {
+
+	var res []result.Issue
+	report := func(decl ast.Decl, format string, args ...interface{}) {
+		res = append(res, result.Issue{
+			Pos:        fset.Position(decl.Pos()),
+			Text:       fmt.Sprintf(format, args...),
+			FromLinter: lint.Name(),
+		})
+	}
+
+	lastKind := ""
+	declsCount := map[string]int{}
+	for _, decl := range f.Decls {
+		kind := decorderDeclKind(decl)
+		if kind == "" {
+			continue
+		}
+
+		declsCount[kind]++
+		if declsCount[kind] > 1 && (kind == "const" && settings.SingleConst || kind == "var" && settings.SingleVar) {
+			report(decl, "multiple `%s` declarations are not allowed; use parentheses instead", kind)
+		}
+
+		kindOrder, ok := order[kind]
+		if !ok {
+			continue
+		}
+
+		if lastKind != "" && kindOrder < order[lastKind] {
+			report(decl, "`%s` must not be placed after `%s` (desired order: %s)",
+				kind, lastKind, strings.Join(settings.DecOrder, ","))
+			continue // keep the latest kind to not report every next declaration of the same kind
+		}
+		lastKind = kind
+	}
+
+	return res
+}
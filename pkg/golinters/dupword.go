@@ -0,0 +1,119 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+	"unicode"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Dupword struct{}
+
+func (Dupword) Name() string {
+	return "dupword"
+}
+
+func (Dupword) Desc() string {
+	return "Checks for consecutive duplicate words in comments"
+}
+
+func (lint Dupword) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	ignored := map[string]bool{}
+	for _, w := range lintCtx.Settings().Dupword.Ignore {
+		ignored[strings.ToLower(w)] = true
+	}
+
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		for _, cg := range f.F.Comments {
+			for _, c := range cg.List {
+				res = append(res, lint.checkComment(c, f.Fset, ignored)...)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (lint Dupword) checkComment(c *ast.Comment, fset *token.FileSet, ignored map[string]bool) []result.Issue {
+	var res []result.Issue
+	words := commentWords(c.Text)
+	for i := 1; i < len(words); i++ {
+		prev, w := words[i-1], words[i]
+		// punctuation separates words: "Hello, hello" and "line (line" are fine
+		if prev.trailingPunct || w.leadingPunct || !strings.EqualFold(prev.text, w.text) || !containsLetter(w.text) ||
+			ignored[strings.ToLower(w.text)] {
+			continue
+		}
+
+		start := fset.Position(c.Pos()).Offset
+		res = append(res, result.Issue{
+			Pos:        fset.Position(c.Pos() + token.Pos(w.start)),
+			Text:       fmt.Sprintf("Duplicate words (%s) found", w.text),
+			FromLinter: lint.Name(),
+			SuggestedFixes: []result.SuggestedFix{{
+				Message: fmt.Sprintf("Remove the duplicate %s", w.text),
+				TextEdits: []result.TextEdit{{
+					Pos: start + prev.end,
+					End: start + w.end,
+				}},
+				Confidence: 0.9, // some duplicates are intended
+			}},
+		})
+	}
+
+	return res
+}
+
+// commentWord is a space-separated word of the comment text without leading and trailing punctuation:
+// start and end are byte offsets of the word in the text.
+type commentWord struct {
+	text          string
+	start, end    int
+	leadingPunct  bool
+	trailingPunct bool
+}
+
+func commentWords(text string) []commentWord {
+	isPunct := func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}
+
+	var words []commentWord
+	for i := 0; i < len(text); {
+		if unicode.IsSpace(rune(text[i])) {
+			i++
+			continue
+		}
+
+		end := strings.IndexFunc(text[i:], unicode.IsSpace)
+		if end == -1 {
+			end = len(text)
+		} else {
+			end += i
+		}
+
+		field := text[i:end]
+		trimmed := strings.TrimRightFunc(field, isPunct)
+		leading := len(trimmed) - len(strings.TrimLeftFunc(trimmed, isPunct))
+		words = append(words, commentWord{
+			text:          trimmed[leading:],
+			start:         i + leading,
+			end:           i + len(trimmed),
+			leadingPunct:  leading != 0,
+			trailingPunct: len(trimmed) != len(field),
+		})
+		i = end
+	}
+
+	return words
+}
+
+func containsLetter(s string) bool {
+	return strings.IndexFunc(s, unicode.IsLetter) != -1
+}
@@ -0,0 +1,187 @@
+package golinters
+
+import (
+	"context"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Errorlint struct{}
+
+func (Errorlint) Name() string {
+	return "errorlint"
+}
+
+func (Errorlint) Desc() string {
+	return "Errorlint finds code that will cause problems with the error wrapping scheme introduced in Go 1.13"
+}
+
+func (lint Errorlint) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	// type info is needed: not compiling packages are reported by typecheck
+	return checkTypedPackages(ctx, lintCtx, lint.Name(), func(ctx context.Context, pkg *packages.Package) []result.Issue {
+		v := errorlintVisitor{
+			ctx:      ctx,
+			settings: &lintCtx.Settings().Errorlint,
+			pkg:      pkg,
+		}
+		for _, f := range pkg.Syntax {
+			ast.Walk(&v, f)
+		}
+		return v.issues
+	}), nil
+}
+
+var errorType = types.Universe.Lookup("error").Type()
+
+type errorlintVisitor struct {
+	ctx      context.Context
+	settings *config.ErrorlintSettings
+	pkg      *packages.Package
+
+	issues []result.Issue
+}
+
+func (v *errorlintVisitor) Visit(node ast.Node) ast.Visitor {
+	if v.ctx.Err() != nil {
+		return nil // the package timeout is exceeded
+	}
+
+	switch n := node.(type) {
+	case *ast.BinaryExpr:
+		if v.settings.Comparison && (n.Op == token.EQL || n.Op == token.NEQ) &&
+			v.isError(n.X) && v.isError(n.Y) && !v.isNil(n.X) && !v.isNil(n.Y) {
+			v.report(n.Pos(), "comparing with "+n.Op.String()+" will fail on wrapped errors")
+		}
+	case *ast.SwitchStmt:
+		if v.settings.Comparison && n.Tag != nil && v.isError(n.Tag) {
+			v.report(n.Pos(), "switch on an error will fail on wrapped errors")
+		}
+	case *ast.TypeAssertExpr:
+		// n.Type is nil in type switches, they are checked below
+		if v.settings.Asserts && n.Type != nil && v.isError(n.X) {
+			v.report(n.Pos(), "type assertion on error will fail on wrapped errors")
+		}
+	case *ast.TypeSwitchStmt:
+		if v.settings.Asserts && v.isError(typeSwitchSubject(n)) {
+			v.report(n.Pos(), "type switch on error will fail on wrapped errors")
+		}
+		// don't report the type assertion of the type switch once more
+		if n.Init != nil {
+			ast.Walk(v, n.Init)
+		}
+		ast.Walk(v, n.Body)
+		return nil
+	case *ast.CallExpr:
+		if v.settings.Errorf {
+			v.checkErrorf(n)
+		}
+	}
+
+	return v
+}
+
+func typeSwitchSubject(ts *ast.TypeSwitchStmt) ast.Expr {
+	var e ast.Expr
+	switch a := ts.Assign.(type) {
+	case *ast.ExprStmt:
+		e = a.X
+	case *ast.AssignStmt:
+		e = a.Rhs[0]
+	}
+
+	if ta, ok := e.(*ast.TypeAssertExpr); ok {
+		return ta.X
+	}
+	return nil
+}
+
+func (v *errorlintVisitor) checkErrorf(call *ast.CallExpr) {
+	fn, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || fn.Sel.Name != "Errorf" || len(call.Args) < 2 {
+		return
+	}
+
+	obj, ok := v.pkg.TypesInfo.Uses[fn.Sel].(*types.Func)
+	if !ok || obj.Pkg() == nil || obj.Pkg().Path() != "fmt" {
+		return
+	}
+
+	format := v.pkg.TypesInfo.Types[call.Args[0]].Value
+	if format == nil || format.Kind() != constant.String {
+		return
+	}
+
+	verbs, ok := parseFormatVerbs(constant.StringVal(format))
+	if !ok {
+		return
+	}
+
+	for i, arg := range call.Args[1:] {
+		if i >= len(verbs) {
+			break
+		}
+		if verbs[i] != 'w' && v.isError(arg) {
+			v.report(arg.Pos(), "non-wrapping format verb for fmt.Errorf. Use `%w` to format errors")
+			return
+		}
+	}
+}
+
+// parseFormatVerbs returns verbs of the printf format, it fails on
+// the formats with explicit argument indexes or `*` width or precision.
+func parseFormatVerbs(format string) ([]rune, bool) {
+	var verbs []rune
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+
+		i++
+		for i < len(format) && strings.IndexByte("+-# 0123456789.", format[i]) != -1 {
+			i++
+		}
+		if i == len(format) {
+			break
+		}
+
+		switch format[i] {
+		case '%':
+			continue
+		case '[', '*':
+			return nil, false
+		}
+		verbs = append(verbs, rune(format[i]))
+	}
+
+	return verbs, true
+}
+
+func (v *errorlintVisitor) isError(e ast.Expr) bool {
+	if e == nil {
+		return false
+	}
+
+	t := v.pkg.TypesInfo.TypeOf(e)
+	return t != nil && types.Identical(t, errorType)
+}
+
+func (v *errorlintVisitor) isNil(e ast.Expr) bool {
+	return v.pkg.TypesInfo.Types[e].IsNil()
+}
+
+func (v *errorlintVisitor) report(pos token.Pos, text string) {
+	v.issues = append(v.issues, result.Issue{
+		Pos:        v.pkg.Fset.Position(pos),
+		Text:       text,
+		FromLinter: Errorlint{}.Name(),
+	})
+}
@@ -0,0 +1,172 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Exportloopref struct{}
+
+func (Exportloopref) Name() string {
+	return "exportloopref"
+}
+
+func (Exportloopref) Desc() string {
+	return "Checks for pointers to enclosing loop variables stored outside of the loop"
+}
+
+func (lint Exportloopref) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		v := exportlooprefVisitor{fset: f.Fset, issues: &res}
+		ast.Walk(&v, f.F)
+	}
+
+	return res, nil
+}
+
+// loopScope is a loop with variables declared by it: they're shared by all iterations before go 1.22
+type loopScope struct {
+	loop ast.Node
+	vars map[*ast.Object]bool
+}
+
+type exportlooprefVisitor struct {
+	fset   *token.FileSet
+	loops  []loopScope // from outermost to innermost
+	issues *[]result.Issue
+}
+
+func (v *exportlooprefVisitor) Visit(node ast.Node) ast.Visitor {
+	switch n := node.(type) {
+	case *ast.RangeStmt:
+		if n.Tok != token.DEFINE {
+			return v
+		}
+		return v.withLoop(n, n.Key, n.Value)
+	case *ast.ForStmt:
+		init, ok := n.Init.(*ast.AssignStmt)
+		if !ok || init.Tok != token.DEFINE {
+			return v
+		}
+		return v.withLoop(n, init.Lhs...)
+	case *ast.AssignStmt:
+		if n.Tok == token.DEFINE || len(n.Lhs) != len(n.Rhs) {
+			return v // variables declared in the loop are new in every iteration
+		}
+		for i, rhs := range n.Rhs {
+			v.checkStored(n.Lhs[i], rhs)
+		}
+	}
+
+	return v
+}
+
+func (v *exportlooprefVisitor) withLoop(loop ast.Node, vars ...ast.Expr) ast.Visitor {
+	scope := loopScope{loop: loop, vars: map[*ast.Object]bool{}}
+	for _, e := range vars {
+		if id, ok := e.(*ast.Ident); ok && id.Obj != nil {
+			scope.vars[id.Obj] = true
+		}
+	}
+
+	loops := make([]loopScope, 0, len(v.loops)+1)
+	loops = append(loops, v.loops...)
+	return &exportlooprefVisitor{
+		fset:   v.fset,
+		loops:  append(loops, scope),
+		issues: v.issues,
+	}
+}
+
+// checkStored reports pointers to loop variables assigned or appended to variables declared outside of the loop.
+func (v *exportlooprefVisitor) checkStored(lhs, rhs ast.Expr) {
+	values := []ast.Expr{rhs}
+	if call, ok := rhs.(*ast.CallExpr); ok {
+		if id, ok := call.Fun.(*ast.Ident); ok && id.Name == "append" && len(call.Args) > 1 {
+			values = call.Args[1:]
+		}
+	}
+
+	for _, value := range values {
+		id, scope := v.loopVarAddress(value)
+		if scope == nil || isDeclaredIn(rootIdent(lhs), scope.loop) {
+			continue
+		}
+
+		*v.issues = append(*v.issues, result.Issue{
+			Pos:        v.fset.Position(value.Pos()),
+			Text:       fmt.Sprintf("exporting a pointer for the loop variable %s", id.Name),
+			FromLinter: Exportloopref{}.Name(),
+		})
+	}
+}
+
+// loopVarAddress returns the loop variable and its loop if the expression is like &v.
+func (v *exportlooprefVisitor) loopVarAddress(e ast.Expr) (*ast.Ident, *loopScope) {
+	u, ok := unparen(e).(*ast.UnaryExpr)
+	if !ok || u.Op != token.AND {
+		return nil, nil
+	}
+
+	id, ok := unparen(u.X).(*ast.Ident)
+	if !ok || id.Obj == nil {
+		return nil, nil
+	}
+
+	for i := len(v.loops) - 1; i >= 0; i-- {
+		if v.loops[i].vars[id.Obj] {
+			return id, &v.loops[i]
+		}
+	}
+
+	return nil, nil
+}
+
+func unparen(e ast.Expr) ast.Expr {
+	for {
+		p, ok := e.(*ast.ParenExpr)
+		if !ok {
+			return e
+		}
+		e = p.X
+	}
+}
+
+// rootIdent returns the variable of expressions like x, x.f or x[i], nil if there is no one.
+func rootIdent(e ast.Expr) *ast.Ident {
+	for {
+		switch ee := e.(type) {
+		case *ast.Ident:
+			return ee
+		case *ast.SelectorExpr:
+			e = ee.X
+		case *ast.IndexExpr:
+			e = ee.X
+		case *ast.StarExpr:
+			e = ee.X
+		case *ast.ParenExpr:
+			e = ee.X
+		default:
+			return nil
+		}
+	}
+}
+
+func isDeclaredIn(id *ast.Ident, n ast.Node) bool {
+	if id == nil || id.Obj == nil {
+		return false // package-level variables of other files
+	}
+
+	decl, ok := id.Obj.Decl.(ast.Node)
+	if !ok {
+		return false
+	}
+
+	return decl.Pos() >= n.Pos() && decl.Pos() < n.End()
+}
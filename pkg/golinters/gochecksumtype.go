@@ -0,0 +1,224 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Gochecksumtype struct{}
+
+func (Gochecksumtype) Name() string {
+	return "gochecksumtype"
+}
+
+func (Gochecksumtype) Desc() string {
+	return "Checks exhaustiveness of type switches on interfaces marked as sum types by //sumtype:decl"
+}
+
+const sumtypeDirective = "//sumtype:decl"
+
+// sumType is an interface declared with //sumtype:decl: its variants are types of its package implementing it.
+type sumType struct {
+	name     string
+	iface    *types.Interface
+	variants []types.Type
+}
+
+func (lint Gochecksumtype) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	sumTypes := map[string]*sumType{}
+	var res []result.Issue
+	for _, pkg := range lintCtx.Packages {
+		if pkg.IllTyped || pkg.TypesInfo == nil {
+			continue
+		}
+
+		res = append(res, lint.collectSumTypes(pkg, sumTypes)...)
+	}
+	if len(sumTypes) == 0 {
+		return res, nil
+	}
+
+	defaultIsExhaustive := lintCtx.Settings().Gochecksumtype.DefaultSignifiesExhaustive
+	for _, pkg := range lintCtx.Packages {
+		if pkg.IllTyped || pkg.TypesInfo == nil {
+			continue
+		}
+
+		for _, f := range pkg.Syntax {
+			ast.Inspect(f, func(node ast.Node) bool {
+				if ts, ok := node.(*ast.TypeSwitchStmt); ok {
+					if i := lint.checkSwitch(pkg, ts, sumTypes, defaultIsExhaustive); i != nil {
+						res = append(res, *i)
+					}
+				}
+				return true
+			})
+		}
+	}
+
+	return res, nil
+}
+
+func sumTypeKey(obj types.Object) string {
+	if obj.Pkg() == nil {
+		return obj.Name()
+	}
+
+	return obj.Pkg().Path() + "." + obj.Name()
+}
+
+func (lint Gochecksumtype) collectSumTypes(pkg *packages.Package, sumTypes map[string]*sumType) []result.Issue {
+	var res []result.Issue
+	for _, f := range pkg.Syntax {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if !hasSumtypeDirective(ts.Doc) && !(len(gd.Specs) == 1 && hasSumtypeDirective(gd.Doc)) {
+					continue
+				}
+
+				obj := pkg.TypesInfo.Defs[ts.Name]
+				if obj == nil {
+					continue
+				}
+
+				iface, ok := obj.Type().Underlying().(*types.Interface)
+				if !ok {
+					res = append(res, result.Issue{
+						Pos:        pkg.Fset.Position(ts.Pos()),
+						Text:       fmt.Sprintf("type %s is marked as a sum type but isn't an interface", ts.Name.Name),
+						FromLinter: lint.Name(),
+					})
+					continue
+				}
+
+				sumTypes[sumTypeKey(obj)] = &sumType{
+					name:     obj.Name(),
+					iface:    iface,
+					variants: sumTypeVariants(pkg.Types, iface),
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+func hasSumtypeDirective(cg *ast.CommentGroup) bool {
+	if cg == nil {
+		return false
+	}
+
+	for _, c := range cg.List {
+		if strings.TrimSpace(c.Text) == sumtypeDirective {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sumTypeVariants returns concrete types of the package implementing the interface: T or *T.
+func sumTypeVariants(pkg *types.Package, iface *types.Interface) []types.Type {
+	var variants []types.Type
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		tn, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || types.IsInterface(tn.Type()) {
+			continue
+		}
+
+		if types.Implements(tn.Type(), iface) {
+			variants = append(variants, tn.Type())
+		} else if ptr := types.NewPointer(tn.Type()); types.Implements(ptr, iface) {
+			variants = append(variants, ptr)
+		}
+	}
+
+	return variants
+}
+
+func (lint Gochecksumtype) checkSwitch(pkg *packages.Package, ts *ast.TypeSwitchStmt, sumTypes map[string]*sumType,
+	defaultIsExhaustive bool) *result.Issue {
+	var ta *ast.TypeAssertExpr
+	switch a := ts.Assign.(type) {
+	case *ast.ExprStmt:
+		ta, _ = a.X.(*ast.TypeAssertExpr)
+	case *ast.AssignStmt:
+		if len(a.Rhs) == 1 {
+			ta, _ = a.Rhs[0].(*ast.TypeAssertExpr)
+		}
+	}
+	if ta == nil {
+		return nil
+	}
+
+	named, ok := pkg.TypesInfo.TypeOf(ta.X).(*types.Named)
+	if !ok {
+		return nil
+	}
+	st := sumTypes[sumTypeKey(named.Obj())]
+	if st == nil {
+		return nil
+	}
+
+	var caseTypes []types.Type
+	for _, stmt := range ts.Body.List {
+		cc := stmt.(*ast.CaseClause)
+		if cc.List == nil && defaultIsExhaustive {
+			return nil
+		}
+
+		for _, e := range cc.List {
+			if t := pkg.TypesInfo.TypeOf(e); t != nil {
+				caseTypes = append(caseTypes, t)
+			}
+		}
+	}
+
+	var missing []string
+	for _, v := range st.variants {
+		if !sumTypeVariantIsCovered(v, caseTypes) {
+			missing = append(missing, types.TypeString(v, types.RelativeTo(pkg.Types)))
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+
+	return &result.Issue{
+		Pos:        pkg.Fset.Position(ts.Pos()),
+		Text:       fmt.Sprintf("exhaustiveness check failed for sum type %s: missing cases for %s", st.name, strings.Join(missing, ", ")),
+		FromLinter: lint.Name(),
+	}
+}
+
+// sumTypeVariantIsCovered checks that a case has the variant type or an interface implemented by it.
+func sumTypeVariantIsCovered(variant types.Type, caseTypes []types.Type) bool {
+	for _, t := range caseTypes {
+		if types.Identical(t, variant) {
+			return true
+		}
+		if iface, ok := t.Underlying().(*types.Interface); ok && types.Implements(variant, iface) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,212 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Gocognit struct{}
+
+func (Gocognit) Name() string {
+	return "gocognit"
+}
+
+func (Gocognit) Desc() string {
+	return "Computes and checks the cognitive complexity of functions"
+}
+
+func (lint Gocognit) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	minComplexity := lintCtx.Settings().Gocognit.MinComplexity
+
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		for _, decl := range f.F.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+
+			complexity := cognitiveComplexity(fn)
+			if complexity <= minComplexity {
+				continue
+			}
+
+			res = append(res, result.Issue{
+				Pos: f.Fset.Position(fn.Pos()),
+				Text: fmt.Sprintf("cognitive complexity %d of func %s is high (> %d)",
+					complexity, formatCode(cognitFuncName(fn), lintCtx.Cfg), minComplexity),
+				FromLinter: lint.Name(),
+			})
+		}
+	}
+
+	return res, nil
+}
+
+func cognitFuncName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+
+	return fmt.Sprintf("(%s).%s", cognitRecvName(fn.Recv.List[0].Type), fn.Name.Name)
+}
+
+func cognitRecvName(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.StarExpr:
+		return "*" + cognitRecvName(e.X)
+	case *ast.Ident:
+		return e.Name
+	}
+
+	return "?"
+}
+
+// cognitiveComplexity computes the complexity by https://www.sonarsource.com/docs/CognitiveComplexity.pdf:
+// breaks of the linear flow increment it, nested ones increment it more.
+func cognitiveComplexity(fn *ast.FuncDecl) int {
+	v := cognitVisitor{
+		fn:         fn,
+		elseIfs:    map[*ast.IfStmt]bool{},
+		logicalOps: map[*ast.BinaryExpr]bool{},
+	}
+	ast.Walk(&v, fn.Body)
+
+	return v.complexity
+}
+
+type cognitVisitor struct {
+	fn         *ast.FuncDecl
+	complexity int
+	nesting    int
+
+	elseIfs    map[*ast.IfStmt]bool     // ifs which are else branches: they aren't nested
+	logicalOps map[*ast.BinaryExpr]bool // parts of already counted sequences of logical operators
+}
+
+func (v *cognitVisitor) walkNested(node ast.Node) {
+	v.nesting++
+	ast.Walk(v, node)
+	v.nesting--
+}
+
+//nolint:gocyclo
+func (v *cognitVisitor) Visit(node ast.Node) ast.Visitor {
+	switch n := node.(type) {
+	case *ast.IfStmt:
+		if v.elseIfs[n] {
+			v.complexity++
+		} else {
+			v.complexity += 1 + v.nesting
+		}
+		if n.Init != nil {
+			ast.Walk(v, n.Init)
+		}
+		ast.Walk(v, n.Cond)
+		v.walkNested(n.Body)
+
+		switch e := n.Else.(type) {
+		case *ast.BlockStmt:
+			v.complexity++
+			v.walkNested(e)
+		case *ast.IfStmt:
+			v.elseIfs[e] = true
+			ast.Walk(v, e)
+		}
+		return nil
+	case *ast.SwitchStmt:
+		v.complexity += 1 + v.nesting
+		if n.Init != nil {
+			ast.Walk(v, n.Init)
+		}
+		if n.Tag != nil {
+			ast.Walk(v, n.Tag)
+		}
+		v.walkNested(n.Body)
+		return nil
+	case *ast.TypeSwitchStmt:
+		v.complexity += 1 + v.nesting
+		if n.Init != nil {
+			ast.Walk(v, n.Init)
+		}
+		ast.Walk(v, n.Assign)
+		v.walkNested(n.Body)
+		return nil
+	case *ast.SelectStmt:
+		v.complexity += 1 + v.nesting
+		v.walkNested(n.Body)
+		return nil
+	case *ast.ForStmt:
+		v.complexity += 1 + v.nesting
+		if n.Init != nil {
+			ast.Walk(v, n.Init)
+		}
+		if n.Cond != nil {
+			ast.Walk(v, n.Cond)
+		}
+		if n.Post != nil {
+			ast.Walk(v, n.Post)
+		}
+		v.walkNested(n.Body)
+		return nil
+	case *ast.RangeStmt:
+		v.complexity += 1 + v.nesting
+		ast.Walk(v, n.X)
+		v.walkNested(n.Body)
+		return nil
+	case *ast.FuncLit:
+		v.walkNested(n.Body)
+		return nil
+	case *ast.BranchStmt:
+		if n.Label != nil {
+			v.complexity++ // goto, break and continue to labels
+		}
+	case *ast.BinaryExpr:
+		if (n.Op == token.LAND || n.Op == token.LOR) && !v.logicalOps[n] {
+			v.complexity += v.countLogicalSequences(n)
+		}
+	case *ast.CallExpr:
+		id, ok := n.Fun.(*ast.Ident)
+		if ok && v.fn.Recv == nil && id.Name == v.fn.Name.Name && (id.Obj == nil || id.Obj.Decl == v.fn) {
+			v.complexity++ // recursion
+		}
+	}
+
+	return v
+}
+
+// countLogicalSequences returns the count of sequences of like logical operators in the expression:
+// a && b && c is one sequence, a && b || c is two.
+func (v *cognitVisitor) countLogicalSequences(e *ast.BinaryExpr) int {
+	var ops []token.Token
+	var collect func(e ast.Expr)
+	collect = func(e ast.Expr) {
+		switch e := e.(type) {
+		case *ast.ParenExpr:
+			collect(e.X)
+		case *ast.BinaryExpr:
+			if e.Op != token.LAND && e.Op != token.LOR {
+				return
+			}
+			v.logicalOps[e] = true
+			collect(e.X)
+			ops = append(ops, e.Op)
+			collect(e.Y)
+		}
+	}
+	collect(e)
+
+	count := 0
+	for i, op := range ops {
+		if i == 0 || op != ops[i-1] {
+			count++
+		}
+	}
+
+	return count
+}
@@ -31,6 +31,15 @@ func (Gocritic) Desc() string {
 	return "The most opinionated Go source code linter"
 }
 
+func (Gocritic) Checks() []linter.Check {
+	var ret []linter.Check
+	for _, info := range lintpack.GetCheckersInfo() {
+		ret = append(ret, linter.Check{ID: info.Name, Desc: info.Summary})
+	}
+
+	return ret
+}
+
 func (Gocritic) normalizeCheckerInfoParams(info *lintpack.CheckerInfo) lintpack.CheckerParams {
 	// lowercase info param keys here because golangci-lint's config parser lowercases all strings
 	ret := lintpack.CheckerParams{}
@@ -0,0 +1,127 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Goerr113 struct{}
+
+func (Goerr113) Name() string {
+	return "goerr113"
+}
+
+func (Goerr113) Desc() string {
+	return "Checks the errors handling expressions: dynamic errors and direct comparisons of errors"
+}
+
+func (lint Goerr113) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	return checkTypedPackages(ctx, lintCtx, lint.Name(), func(ctx context.Context, pkg *packages.Package) []result.Issue {
+		v := goerr113Visitor{ctx: ctx, settings: &lintCtx.Settings().Goerr113, pkg: pkg, cfg: lintCtx.Cfg}
+		for _, f := range pkg.Syntax {
+			ast.Walk(&v, f)
+		}
+		return v.issues
+	}), nil
+}
+
+type goerr113Visitor struct {
+	ctx      context.Context
+	settings *config.Goerr113Settings
+	pkg      *packages.Package
+	cfg      *config.Config
+
+	inIsMethod bool
+	issues     []result.Issue
+}
+
+func (v *goerr113Visitor) Visit(node ast.Node) ast.Visitor {
+	if v.ctx.Err() != nil {
+		return nil // the package timeout is exceeded
+	}
+
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		// methods Is implementing errors.Is compare errors directly
+		v.inIsMethod = n.Recv != nil && n.Name.Name == "Is"
+	case *ast.ReturnStmt:
+		if v.settings.DynamicErrors {
+			for _, e := range n.Results {
+				if call, ok := e.(*ast.CallExpr); ok {
+					v.checkDynamicError(call)
+				}
+			}
+		}
+	case *ast.BinaryExpr:
+		if v.settings.Comparison && !v.inIsMethod && (n.Op == token.EQL || n.Op == token.NEQ) && v.isErrorComparison(n) {
+			suggestion := fmt.Sprintf("errors.Is(%s, %s)", types.ExprString(n.X), types.ExprString(n.Y))
+			if n.Op == token.NEQ {
+				suggestion = "!" + suggestion
+			}
+			v.report(n.Pos(), fmt.Sprintf("do not compare errors directly %s, use %s instead",
+				formatCode(types.ExprString(n), v.cfg), formatCode(suggestion, v.cfg)))
+		}
+	}
+
+	return v
+}
+
+// checkDynamicError reports errors.New and fmt.Errorf not wrapping another error by %w.
+func (v *goerr113Visitor) checkDynamicError(call *ast.CallExpr) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+
+	fn, ok := v.pkg.TypesInfo.Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return
+	}
+
+	name := fn.Pkg().Path() + "." + fn.Name()
+	switch name {
+	case "errors.New":
+	case "fmt.Errorf":
+		if len(call.Args) != 0 {
+			format := v.pkg.TypesInfo.Types[call.Args[0]].Value
+			if format != nil && format.Kind() == constant.String && strings.Contains(constant.StringVal(format), "%w") {
+				return
+			}
+		}
+	default:
+		return
+	}
+
+	v.report(call.Pos(), fmt.Sprintf("do not define dynamic errors, use wrapped static errors instead: %s",
+		formatCode(name, v.cfg)))
+}
+
+func (v *goerr113Visitor) isErrorComparison(e *ast.BinaryExpr) bool {
+	for _, side := range []ast.Expr{e.X, e.Y} {
+		t := v.pkg.TypesInfo.TypeOf(side)
+		if t == nil || !types.Identical(t, errorType) || v.pkg.TypesInfo.Types[side].IsNil() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *goerr113Visitor) report(pos token.Pos, text string) {
+	v.issues = append(v.issues, result.Issue{
+		Pos:        v.pkg.Fset.Position(pos),
+		Text:       text,
+		FromLinter: Goerr113{}.Name(),
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"go/token"
+	"io/ioutil"
 
 	gofmtAPI "github.com/golangci/gofmt/gofmt"
 	goimportsAPI "github.com/golangci/gofmt/goimports"
@@ -58,7 +59,48 @@ func getFirstDeletedAndAddedLineNumberInHunk(h *diffpkg.Hunk) (firstDeleted, fir
 	return 0, firstAddedLineNumber, fmt.Errorf("didn't find deletion line in hunk %s", string(h.Body))
 }
 
-func (g Gofmt) extractIssuesFromPatch(patch string, log logutils.Log) ([]result.Issue, error) {
+// lineOffset returns the offset of the beginning of the 1-based line
+// or the length of src if there is no such line.
+func lineOffset(src []byte, line int) int {
+	offset := 0
+	for l := 1; l < line; l++ {
+		next := bytes.IndexByte(src[offset:], '\n')
+		if next == -1 {
+			return len(src)
+		}
+		offset += next + 1
+	}
+
+	return offset
+}
+
+// makeHunkFix makes fix replacing original lines of the hunk by new ones.
+func makeHunkFix(h *diffpkg.Hunk, src []byte) result.SuggestedFix {
+	var newText bytes.Buffer
+	for _, line := range bytes.Split(h.Body, []byte{'\n'}) {
+		if len(line) != 0 && (line[0] == ' ' || line[0] == '+') {
+			newText.Write(line[1:])
+			newText.WriteByte('\n')
+		}
+	}
+
+	startLine := int(h.OrigStartLine)
+	if h.OrigLines == 0 { // lines are added after the start line
+		startLine++
+	}
+
+	return result.SuggestedFix{
+		Message: "Format code",
+		TextEdits: []result.TextEdit{{
+			Pos:     lineOffset(src, startLine),
+			End:     lineOffset(src, startLine+int(h.OrigLines)),
+			NewText: newText.String(),
+		}},
+		Confidence: 1,
+	}
+}
+
+func (g Gofmt) extractIssuesFromPatch(patch string, src []byte, log logutils.Log) ([]result.Issue, error) {
 	diffs, err := diffpkg.ParseMultiFileDiff([]byte(patch))
 	if err != nil {
 		return nil, fmt.Errorf("can't parse patch: %s", err)
@@ -95,7 +137,8 @@ func (g Gofmt) extractIssuesFromPatch(patch string, log logutils.Log) ([]result.
 					Filename: d.NewName,
 					Line:     deletedLine,
 				},
-				Text: text,
+				Text:           text,
+				SuggestedFixes: []result.SuggestedFix{makeHunkFix(hunk, src)},
 			}
 			issues = append(issues, i)
 		}
@@ -108,6 +151,10 @@ func (g Gofmt) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue
 	var issues []result.Issue
 
 	for _, f := range getAllFileNames(lintCtx) {
+		if af := lintCtx.ASTCache.Get(f); af != nil && af.SyntaxErr != nil {
+			continue // a file with syntax errors can't be formatted, errors are reported by typecheck
+		}
+
 		var diff []byte
 		var err error
 		if g.UseGoimports {
@@ -123,7 +170,12 @@ func (g Gofmt) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue
 			continue
 		}
 
-		is, err := g.extractIssuesFromPatch(string(diff), lintCtx.Log)
+		src, err := ioutil.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+
+		is, err := g.extractIssuesFromPatch(string(diff), src, lintCtx.Log)
 		if err != nil {
 			return nil, fmt.Errorf("can't extract issues from gofmt diff output %q: %s", string(diff), err)
 		}
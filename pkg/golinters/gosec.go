@@ -6,7 +6,9 @@ import (
 	"go/token"
 	"io/ioutil"
 	"log"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/golangci/gosec"
 	"github.com/golangci/gosec/rules"
@@ -25,7 +27,29 @@ func (Gosec) Desc() string {
 	return "Inspects source code for security problems"
 }
 
+func (Gosec) Checks() []linter.Check {
+	var ret []linter.Check
+	for _, r := range rules.Generate() {
+		ret = append(ret, linter.Check{ID: r.ID, Desc: r.Description})
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].ID < ret[j].ID
+	})
+	return ret
+}
+
 func (lint Gosec) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	settings := lintCtx.Settings().Gosec
+	minSeverity, err := parseGosecScore(settings.Severity)
+	if err != nil {
+		return nil, fmt.Errorf("invalid severity: %s", err)
+	}
+	minConfidence, err := parseGosecScore(settings.Confidence)
+	if err != nil {
+		return nil, fmt.Errorf("invalid confidence: %s", err)
+	}
+
 	gasConfig := gosec.NewConfig()
 	enabledRules := rules.Generate()
 	logger := log.New(ioutil.Discard, "", 0)
@@ -34,6 +58,7 @@ func (lint Gosec) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Is
 
 	analyzer.ProcessProgram(lintCtx.Program)
 	issues, _ := analyzer.Report()
+	issues = filterGosecIssues(issues, minSeverity, minConfidence)
 	if len(issues) == 0 {
 		return nil, nil
 	}
@@ -58,6 +83,7 @@ func (lint Gosec) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Is
 				Line:     line,
 			},
 			Text:       text,
+			Severity:   strings.ToLower(i.Severity.String()),
 			LineRange:  r,
 			FromLinter: lint.Name(),
 		})
@@ -65,3 +91,28 @@ func (lint Gosec) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Is
 
 	return res, nil
 }
+
+func parseGosecScore(s string) (gosec.Score, error) {
+	switch strings.ToLower(s) {
+	case "", "low":
+		return gosec.Low, nil
+	case "medium":
+		return gosec.Medium, nil
+	case "high":
+		return gosec.High, nil
+	}
+
+	return gosec.Low, fmt.Errorf("unknown level %q, only (low|medium|high) allowed", s)
+}
+
+// filterGosecIssues keeps issues with severity and confidence not lower than the given levels.
+func filterGosecIssues(issues []*gosec.Issue, minSeverity, minConfidence gosec.Score) []*gosec.Issue {
+	var ret []*gosec.Issue
+	for _, i := range issues {
+		if i.Severity >= minSeverity && i.Confidence >= minConfidence {
+			ret = append(ret, i)
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,30 @@
+package golinters
+
+import (
+	"testing"
+
+	"github.com/golangci/gosec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterGosecIssues(t *testing.T) {
+	lowConfidence := &gosec.Issue{RuleID: "G104", Severity: gosec.High, Confidence: gosec.Low}
+	highConfidence := &gosec.Issue{RuleID: "G401", Severity: gosec.Medium, Confidence: gosec.High}
+	issues := []*gosec.Issue{lowConfidence, highConfidence}
+
+	assert.Equal(t, issues, filterGosecIssues(issues, gosec.Low, gosec.Low))
+	assert.Equal(t, []*gosec.Issue{highConfidence}, filterGosecIssues(issues, gosec.Low, gosec.High))
+	assert.Equal(t, []*gosec.Issue{lowConfidence}, filterGosecIssues(issues, gosec.High, gosec.Low))
+	assert.Empty(t, filterGosecIssues(issues, gosec.High, gosec.High))
+}
+
+func TestParseGosecScore(t *testing.T) {
+	for s, score := range map[string]gosec.Score{"": gosec.Low, "low": gosec.Low, "Medium": gosec.Medium, "high": gosec.High} {
+		got, err := parseGosecScore(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, score, got, s)
+	}
+
+	_, err := parseGosecScore("critical")
+	assert.Error(t, err)
+}
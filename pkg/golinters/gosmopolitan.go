@@ -0,0 +1,113 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strconv"
+	"unicode"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Gosmopolitan struct{}
+
+func (Gosmopolitan) Name() string {
+	return "gosmopolitan"
+}
+
+func (Gosmopolitan) Desc() string {
+	return "Report certain i18n/l10n anti-patterns in your Go codebase"
+}
+
+func (lint Gosmopolitan) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	settings := lintCtx.Settings().Gosmopolitan
+
+	scripts := map[string]*unicode.RangeTable{}
+	for _, name := range settings.WatchForScripts {
+		table, ok := unicode.Scripts[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown unicode script %q", name)
+		}
+		scripts[name] = table
+	}
+
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		v := gosmopolitanVisitor{
+			fset:    f.Fset,
+			scripts: scripts,
+		}
+		if !settings.AllowTimeLocal {
+			v.timePkg = getImportName(f.F, "time")
+		}
+		ast.Walk(&v, f.F)
+		res = append(res, v.issues...)
+	}
+
+	return res, nil
+}
+
+type gosmopolitanVisitor struct {
+	fset    *token.FileSet
+	scripts map[string]*unicode.RangeTable
+	timePkg string // "" if time.Local usage isn't checked
+
+	issues []result.Issue
+}
+
+func (v *gosmopolitanVisitor) Visit(node ast.Node) ast.Visitor {
+	switch n := node.(type) {
+	case *ast.ImportSpec:
+		return nil // import paths aren't user-facing strings
+	case *ast.Field:
+		// struct tags aren't user-facing strings
+		for _, name := range n.Names {
+			ast.Walk(v, name)
+		}
+		ast.Walk(v, n.Type)
+		return nil
+	case *ast.BasicLit:
+		v.checkLit(n)
+	case *ast.SelectorExpr:
+		if v.timePkg != "" && isPkgSelector(n, v.timePkg, "Local") {
+			v.report(n.Pos(), fmt.Sprintf("usage of %s", formatCode(qualifyPkgName(v.timePkg, "Local"), nil)))
+		}
+	}
+
+	return v
+}
+
+func (v *gosmopolitanVisitor) checkLit(lit *ast.BasicLit) {
+	if lit.Kind != token.STRING && lit.Kind != token.CHAR {
+		return
+	}
+
+	s := lit.Value
+	if lit.Kind == token.STRING {
+		unquoted, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return
+		}
+		s = unquoted
+	}
+
+	for _, r := range s {
+		for name, table := range v.scripts {
+			if unicode.Is(table, r) {
+				v.report(lit.Pos(), fmt.Sprintf("string literal contains rune in %s script", name))
+				return
+			}
+		}
+	}
+}
+
+func (v *gosmopolitanVisitor) report(pos token.Pos, text string) {
+	v.issues = append(v.issues, result.Issue{
+		Pos:        v.fset.Position(pos),
+		Text:       text,
+		FromLinter: Gosmopolitan{}.Name(),
+	})
+}
@@ -0,0 +1,151 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Importas struct{}
+
+func (Importas) Name() string {
+	return "importas"
+}
+
+func (Importas) Desc() string {
+	return "Enforces consistent import aliases"
+}
+
+type importasRule struct {
+	pkg   *regexp.Regexp
+	alias string
+}
+
+// requiredAlias returns the alias required for the import path: its submatches are expanded in the alias.
+func (r importasRule) requiredAlias(path string) (string, bool) {
+	m := r.pkg.FindStringSubmatchIndex(path)
+	if m == nil {
+		return "", false
+	}
+
+	return string(r.pkg.ExpandString(nil, r.alias, path, m)), true
+}
+
+func (lint Importas) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	settings := lintCtx.Settings().Importas
+
+	var rules []importasRule
+	for _, a := range settings.Alias {
+		re, err := regexp.Compile("^(?:" + a.Pkg + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("can't compile importas package regexp %q: %s", a.Pkg, err)
+		}
+		rules = append(rules, importasRule{pkg: re, alias: a.Alias})
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		for _, spec := range f.F.Imports {
+			if i := lint.checkImport(f.F, f.Fset, spec, rules, settings, lintCtx.Cfg); i != nil {
+				res = append(res, *i)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (lint Importas) checkImport(f *ast.File, fset *token.FileSet, spec *ast.ImportSpec, rules []importasRule,
+	settings config.ImportasSettings, cfg *config.Config) *result.Issue {
+	path, err := strconv.Unquote(spec.Path.Value)
+	if err != nil {
+		return nil
+	}
+
+	for _, r := range rules {
+		want, ok := r.requiredAlias(path)
+		if !ok {
+			continue
+		}
+
+		var text string
+		if spec.Name == nil {
+			if !settings.NoUnaliased || importPathName(path) == want {
+				return nil
+			}
+			text = fmt.Sprintf("import %s imported without alias but must be with alias %s according to config",
+				formatCode(path, cfg), formatCode(want, cfg))
+		} else if name := spec.Name.Name; name != want && name != "_" && name != "." {
+			text = fmt.Sprintf("import %s imported as %s but must be %s according to config",
+				formatCode(path, cfg), formatCode(name, cfg), formatCode(want, cfg))
+		} else {
+			return nil
+		}
+
+		return &result.Issue{
+			Pos:        fset.Position(spec.Pos()),
+			Text:       text,
+			FromLinter: lint.Name(),
+			SuggestedFixes: []result.SuggestedFix{{
+				Message:    fmt.Sprintf("Import as %s", want),
+				TextEdits:  importasRenameEdits(f, fset, spec, path, want),
+				Confidence: 0.9, // the alias can conflict with other names of the file
+			}},
+		}
+	}
+
+	return nil
+}
+
+// importasRenameEdits renames the import and its usages in the file. Package names aren't resolved
+// by the parser, so usages are selectors of the old name without an object.
+func importasRenameEdits(f *ast.File, fset *token.FileSet, spec *ast.ImportSpec, path, alias string) []result.TextEdit {
+	offset := func(pos token.Pos) int {
+		return fset.Position(pos).Offset
+	}
+
+	var edits []result.TextEdit
+	var oldName string
+	if spec.Name == nil {
+		oldName = importPathName(path)
+		edits = append(edits, result.TextEdit{Pos: offset(spec.Path.Pos()), End: offset(spec.Path.Pos()), NewText: alias + " "})
+	} else {
+		oldName = spec.Name.Name
+		edits = append(edits, result.TextEdit{Pos: offset(spec.Name.Pos()), End: offset(spec.Name.End()), NewText: alias})
+	}
+
+	ast.Inspect(f, func(node ast.Node) bool {
+		sel, ok := node.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		if id, ok := sel.X.(*ast.Ident); ok && id.Name == oldName && id.Obj == nil {
+			edits = append(edits, result.TextEdit{Pos: offset(id.Pos()), End: offset(id.End()), NewText: alias})
+		}
+		return true
+	})
+
+	return edits
+}
+
+// importPathName guesses the package name by the import path, e.g. yaml for gopkg.in/yaml.v2.
+func importPathName(path string) string {
+	name := path[strings.LastIndex(path, "/")+1:]
+	if i := strings.Index(name, "."); i != -1 {
+		name = name[:i]
+	}
+
+	return name
+}
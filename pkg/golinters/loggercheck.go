@@ -0,0 +1,136 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Loggercheck struct{}
+
+func (Loggercheck) Name() string {
+	return "loggercheck"
+}
+
+func (Loggercheck) Desc() string {
+	return "Checks key-value pairs of structured logging calls: their count must be even and keys must be strings"
+}
+
+// loggercheckFieldTypes are passed to structured loggers instead of key-value pairs
+var loggercheckFieldTypes = map[string]bool{
+	"log/slog.Attr":                 true,
+	"go.uber.org/zap/zapcore.Field": true,
+	"go.uber.org/zap.Field":         true, // an alias of zapcore.Field
+}
+
+func (lint Loggercheck) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	funcs := map[string]bool{}
+	for _, name := range lintCtx.Settings().Loggercheck.Funcs {
+		funcs[name] = true
+	}
+
+	return checkTypedPackages(ctx, lintCtx, lint.Name(), func(ctx context.Context, pkg *packages.Package) []result.Issue {
+		var res []result.Issue
+		for _, f := range pkg.Syntax {
+			ast.Inspect(f, func(node ast.Node) bool {
+				if ctx.Err() != nil {
+					return false // the package timeout is exceeded
+				}
+
+				call, ok := node.(*ast.CallExpr)
+				if !ok || call.Ellipsis.IsValid() {
+					return true // args passed by a slice can't be checked
+				}
+
+				fn := loggercheckCallee(pkg.TypesInfo, call)
+				if fn == nil || !funcs[fn.FullName()] {
+					return true
+				}
+
+				if i := lint.checkCall(pkg, call, fn, lintCtx.Cfg); i != nil {
+					res = append(res, *i)
+				}
+				return true
+			})
+		}
+		return res
+	}), nil
+}
+
+func loggercheckCallee(info *types.Info, call *ast.CallExpr) *types.Func {
+	var id *ast.Ident
+	switch fun := unparen(call.Fun).(type) {
+	case *ast.Ident:
+		id = fun
+	case *ast.SelectorExpr:
+		id = fun.Sel
+	default:
+		return nil
+	}
+
+	fn, _ := info.Uses[id].(*types.Func)
+	return fn
+}
+
+func (lint Loggercheck) checkCall(pkg *packages.Package, call *ast.CallExpr, fn *types.Func, cfg *config.Config) *result.Issue {
+	sig := fn.Type().(*types.Signature)
+	if !sig.Variadic() || len(call.Args) < sig.Params().Len()-1 {
+		return nil
+	}
+
+	args := call.Args[sig.Params().Len()-1:]
+	for i := 0; i < len(args); i++ {
+		t := pkg.TypesInfo.TypeOf(args[i])
+		if t == nil || isLoggercheckField(t) {
+			continue
+		}
+
+		if !isLoggercheckKey(t) {
+			return &result.Issue{
+				Pos:        pkg.Fset.Position(args[i].Pos()),
+				Text:       fmt.Sprintf("logging keys must be strings, got %s", formatCode(types.TypeString(t, types.RelativeTo(pkg.Types)), cfg)),
+				FromLinter: lint.Name(),
+			}
+		}
+
+		if i+1 == len(args) {
+			return &result.Issue{
+				Pos:        pkg.Fset.Position(call.Pos()),
+				Text:       fmt.Sprintf("odd number of arguments passed as key-value pairs for logging to %s", formatCode(fn.Name(), cfg)),
+				FromLinter: lint.Name(),
+			}
+		}
+		i++ // the value
+	}
+
+	return nil
+}
+
+// isLoggercheckField checks named types and aliases: both have type names.
+func isLoggercheckField(t types.Type) bool {
+	named, ok := t.(interface{ Obj() *types.TypeName })
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	return loggercheckFieldTypes[named.Obj().Pkg().Path()+"."+named.Obj().Name()]
+}
+
+// isLoggercheckKey checks the type can be a key: keys of interface types are known only at runtime.
+func isLoggercheckKey(t types.Type) bool {
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		return u.Info()&types.IsString != 0
+	case *types.Interface:
+		return true
+	}
+
+	return false
+}
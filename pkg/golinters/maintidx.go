@@ -0,0 +1,207 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"math"
+	"sort"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Maintidx struct{}
+
+func (Maintidx) Name() string {
+	return "maintidx"
+}
+
+func (Maintidx) Desc() string {
+	return "Measures the maintainability index of each function"
+}
+
+func (m Maintidx) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	under := lintCtx.Settings().Maintidx.Under
+
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		for _, decl := range f.F.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+
+			s := computeMaintidxStat(fn, f.Fset)
+			if s.index >= under {
+				continue
+			}
+
+			res = append(res, result.Issue{
+				Pos: f.Fset.Position(fn.Pos()),
+				Text: fmt.Sprintf("maintainability index %d of func %s is low (< %d): "+
+					"cyclomatic complexity %d, Halstead volume %.2f, %d lines",
+					s.index, formatCode(funcDeclName(fn), lintCtx.Cfg), under,
+					s.complexity, s.volume, s.lines),
+				FromLinter: m.Name(),
+			})
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Pos.Filename < res[j].Pos.Filename ||
+			res[i].Pos.Filename == res[j].Pos.Filename && res[i].Pos.Offset < res[j].Pos.Offset
+	})
+
+	return res, nil
+}
+
+type maintidxStat struct {
+	complexity int
+	volume     float64
+	lines      int
+	index      int
+}
+
+// computeMaintidxStat computes the maintainability index by the formula used in Visual Studio:
+// max(0, (171 - 5.2 * ln(HalsteadVolume) - 0.23 * CyclomaticComplexity - 16.2 * ln(LinesOfCode)) * 100 / 171).
+func computeMaintidxStat(fn *ast.FuncDecl, fset *token.FileSet) maintidxStat {
+	v := maintidxVisitor{
+		complexity: 1,
+		operators:  map[string]int{},
+		operands:   map[string]int{},
+	}
+	ast.Walk(&v, fn)
+
+	s := maintidxStat{
+		complexity: v.complexity,
+		volume:     v.halsteadVolume(),
+		lines:      fset.Position(fn.End()).Line - fset.Position(fn.Pos()).Line + 1,
+	}
+
+	index := 171 - 5.2*safeLog(s.volume) - 0.23*float64(s.complexity) - 16.2*safeLog(float64(s.lines))
+	s.index = int(math.Max(0, index*100/171))
+	return s
+}
+
+func safeLog(x float64) float64 {
+	if x <= 1 {
+		return 0
+	}
+
+	return math.Log(x)
+}
+
+type maintidxVisitor struct {
+	complexity int
+
+	// distinct operators and operands with their occurrences count for Halstead metrics
+	operators, operands map[string]int
+}
+
+func (v maintidxVisitor) halsteadVolume() float64 {
+	length, vocabulary := 0, len(v.operators)+len(v.operands)
+	for _, n := range v.operators {
+		length += n
+	}
+	for _, n := range v.operands {
+		length += n
+	}
+
+	if vocabulary == 0 {
+		return 0
+	}
+
+	return float64(length) * math.Log2(float64(vocabulary))
+}
+
+//nolint:gocyclo
+func (v *maintidxVisitor) Visit(node ast.Node) ast.Visitor {
+	switch n := node.(type) {
+	case *ast.Ident:
+		v.operands[n.Name]++
+	case *ast.BasicLit:
+		v.operands[n.Value]++
+	case *ast.BinaryExpr:
+		v.operators[n.Op.String()]++
+		if n.Op == token.LAND || n.Op == token.LOR {
+			v.complexity++
+		}
+	case *ast.UnaryExpr:
+		v.operators[n.Op.String()]++
+	case *ast.AssignStmt:
+		v.operators[n.Tok.String()]++
+	case *ast.IncDecStmt:
+		v.operators[n.Tok.String()]++
+	case *ast.BranchStmt:
+		v.operators[n.Tok.String()]++
+	case *ast.SendStmt:
+		v.operators["<-"]++
+	case *ast.StarExpr:
+		v.operators["*"]++
+	case *ast.CallExpr:
+		v.operators["()"]++
+	case *ast.IndexExpr:
+		v.operators["[]"]++
+	case *ast.SliceExpr:
+		v.operators["[:]"]++
+	case *ast.SelectorExpr:
+		v.operators["."]++
+	case *ast.TypeAssertExpr:
+		v.operators[".()"]++
+	case *ast.KeyValueExpr:
+		v.operators[":"]++
+	case *ast.CompositeLit:
+		v.operators["{}"]++
+	case *ast.FuncLit:
+		v.operators["func"]++
+	case *ast.ReturnStmt:
+		v.operators["return"]++
+	case *ast.GoStmt:
+		v.operators["go"]++
+	case *ast.DeferStmt:
+		v.operators["defer"]++
+	case *ast.IfStmt:
+		v.operators["if"]++
+		v.complexity++
+	case *ast.ForStmt:
+		v.operators["for"]++
+		v.complexity++
+	case *ast.RangeStmt:
+		v.operators["range"]++
+		v.complexity++
+	case *ast.SwitchStmt, *ast.TypeSwitchStmt:
+		v.operators["switch"]++
+	case *ast.SelectStmt:
+		v.operators["select"]++
+	case *ast.CaseClause:
+		v.operators["case"]++
+		if n.List != nil {
+			v.complexity++
+		}
+	case *ast.CommClause:
+		v.operators["case"]++
+		if n.Comm != nil {
+			v.complexity++
+		}
+	}
+
+	return v
+}
+
+func funcDeclName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+
+	recv := fn.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	if ident, ok := recv.(*ast.Ident); ok {
+		return fmt.Sprintf("(%s).%s", ident.Name, fn.Name.Name)
+	}
+
+	return fn.Name.Name
+}
@@ -19,6 +19,7 @@ import (
 	"golang.org/x/tools/go/packages"
 
 	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/goutil"
 	libpackages "github.com/golangci/golangci-lint/pkg/packages"
 
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
@@ -114,6 +115,31 @@ func (megacheck) Desc() string {
 	return "" // shouldn't be called
 }
 
+func (m megacheck) Checks() []linter.Check {
+	var checkers []lint.Checker
+	if m.gosimpleEnabled {
+		checkers = append(checkers, simple.NewChecker())
+	}
+	if m.staticcheckEnabled {
+		checkers = append(checkers, staticcheck.NewChecker())
+	}
+	if m.stylecheckEnabled {
+		checkers = append(checkers, stylecheck.NewChecker())
+	}
+	if m.unusedEnabled {
+		checkers = append(checkers, unused.NewLintChecker(unused.NewChecker(unused.CheckAll)))
+	}
+
+	var ret []linter.Check
+	for _, c := range checkers {
+		for _, check := range c.Checks() {
+			ret = append(ret, linter.Check{ID: check.ID})
+		}
+	}
+
+	return ret
+}
+
 func (m *megacheck) enableChildLinter(name string) error {
 	switch name {
 	case MegacheckStaticcheckName:
@@ -240,7 +266,7 @@ func (m megacheck) Run(ctx context.Context, lintCtx *linter.Context) ([]result.I
 		return nil, nil
 	}
 
-	issues, err := m.runMegacheck(lintCtx.Packages, lintCtx.Settings().Unused.CheckExported)
+	issues, err := m.runMegacheck(lintCtx.Packages, lintCtx.Settings().Unused.CheckExported, lintCtx.GoVersion)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to run megacheck")
 	}
@@ -257,16 +283,22 @@ func (m megacheck) Run(ctx context.Context, lintCtx *linter.Context) ([]result.I
 			continue
 		}
 
-		res = append(res, result.Issue{
-			Pos:        i.Position,
-			Text:       markIdentifiers(i.Text),
-			FromLinter: i.Checker,
-		})
+		res = append(res, megacheckProblemToIssue(i))
 	}
 	return res, nil
 }
 
-func (m megacheck) runMegacheck(workingPkgs []*packages.Package, checkExportedUnused bool) ([]lint.Problem, error) {
+func megacheckProblemToIssue(p lint.Problem) result.Issue {
+	return result.Issue{
+		Pos:        p.Position, // keep byte offset too: some editors prefer it to line and column
+		Text:       markIdentifiers(p.Text),
+		FromLinter: p.Checker,
+	}
+}
+
+func (m megacheck) runMegacheck(workingPkgs []*packages.Package, checkExportedUnused bool,
+	goVersion string) ([]lint.Problem, error) {
+
 	var checkers []lint.Checker
 
 	if m.gosimpleEnabled {
@@ -289,17 +321,23 @@ func (m megacheck) runMegacheck(workingPkgs []*packages.Package, checkExportedUn
 		return nil, nil
 	}
 
-	cfg := config.Config{}
-	opts := &lintutil.Options{
-		// TODO: get current go version, but now it doesn't matter,
-		// may be needed after next updates of megacheck
-		GoVersion: 11,
+	return runMegacheckCheckers(checkers, megacheckOptions(goVersion), workingPkgs)
+}
 
-		Config: cfg,
-		// TODO: support Ignores option
+const megacheckDefaultGoMinorVersion = 11
+
+func megacheckOptions(goVersion string) *lintutil.Options {
+	goMinorVersion, err := goutil.ParseMinorVersion(goVersion)
+	if err != nil {
+		goMinorVersion = megacheckDefaultGoMinorVersion
 	}
 
-	return runMegacheckCheckers(checkers, opts, workingPkgs)
+	return &lintutil.Options{
+		GoVersion: goMinorVersion,
+
+		Config: config.Config{},
+		// TODO: support Ignores option
+	}
 }
 
 // parseIgnore is a copy from megacheck code just to not fork megacheck
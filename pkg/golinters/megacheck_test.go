@@ -0,0 +1,56 @@
+package golinters
+
+import (
+	"encoding/json"
+	"go/token"
+	"testing"
+
+	"github.com/golangci/go-tools/lint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/goutil"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestMegacheckProblemToIssueKeepsOffset(t *testing.T) {
+	pos := token.Position{
+		Filename: "f.go",
+		Offset:   42,
+		Line:     3,
+		Column:   5,
+	}
+	i := megacheckProblemToIssue(lint.Problem{
+		Position: pos,
+		Text:     "should omit nil check",
+		Checker:  "gosimple",
+	})
+	assert.Equal(t, pos, i.Pos)
+
+	serialized, err := json.Marshal(i)
+	require.NoError(t, err)
+	assert.Contains(t, string(serialized), `"Offset":42`)
+
+	var deserialized result.Issue
+	require.NoError(t, json.Unmarshal(serialized, &deserialized))
+	assert.Equal(t, pos, deserialized.Pos)
+}
+
+func TestStaticcheckChecks(t *testing.T) {
+	var ids []string
+	for _, c := range NewStaticcheck().Checks() {
+		ids = append(ids, c.ID)
+	}
+
+	assert.Contains(t, ids, "SA1000")
+	assert.Contains(t, ids, "SA4006")
+	assert.NotContains(t, ids, "S1000") // it's a gosimple check
+}
+
+func TestMegacheckOptionsGoVersion(t *testing.T) {
+	goVersion, err := goutil.Version("1.9", "")
+	require.NoError(t, err)
+	assert.Equal(t, 9, megacheckOptions(goVersion).GoVersion)
+
+	assert.Equal(t, megacheckDefaultGoMinorVersion, megacheckOptions("").GoVersion)
+}
@@ -0,0 +1,210 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Mnd struct{}
+
+func (Mnd) Name() string {
+	return "mnd"
+}
+
+func (Mnd) Desc() string {
+	return "Detects magic numbers: numeric literals used outside of constant declarations"
+}
+
+const (
+	mndCheckArgument  = "argument"
+	mndCheckAssign    = "assign"
+	mndCheckCase      = "case"
+	mndCheckCondition = "condition"
+	mndCheckIndex     = "index"
+	mndCheckOperation = "operation"
+	mndCheckReturn    = "return"
+)
+
+var mndChecks = []string{
+	mndCheckArgument, mndCheckAssign, mndCheckCase, mndCheckCondition,
+	mndCheckIndex, mndCheckOperation, mndCheckReturn,
+}
+
+func (lint Mnd) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	settings := &lintCtx.Settings().Mnd
+
+	checks := map[string]bool{}
+	for _, c := range settings.Checks {
+		if !isMndCheck(c) {
+			return nil, fmt.Errorf("unknown check %q, only (%s) allowed", c, strings.Join(mndChecks, "|"))
+		}
+		checks[c] = true
+	}
+
+	var ignoredNumbers []constant.Value
+	for _, n := range settings.IgnoredNumbers {
+		v := parseMndNumber(n)
+		if v.Kind() == constant.Unknown {
+			return nil, fmt.Errorf("invalid ignored number %q", n)
+		}
+		ignoredNumbers = append(ignoredNumbers, v)
+	}
+
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		v := mndVisitor{
+			checks:         checks,
+			ignoredNumbers: ignoredNumbers,
+		}
+		ast.Inspect(f.F, v.visit)
+
+		for _, n := range v.numbers {
+			res = append(res, result.Issue{
+				Pos:        f.Fset.Position(n.expr.Pos()),
+				Text:       fmt.Sprintf("Magic number: %s, in <%s> detected", n.value, n.check),
+				FromLinter: lint.Name(),
+			})
+		}
+	}
+
+	return res, nil
+}
+
+func isMndCheck(check string) bool {
+	for _, c := range mndChecks {
+		if c == check {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseMndNumber parses numbers like 1, -1, 0.5 or 0x10, it returns an unknown value if n isn't a number.
+func parseMndNumber(n string) constant.Value {
+	neg := strings.HasPrefix(n, "-")
+	n = strings.TrimPrefix(n, "-")
+
+	v := constant.MakeFromLiteral(n, token.INT, 0)
+	if v.Kind() == constant.Unknown {
+		v = constant.MakeFromLiteral(n, token.FLOAT, 0)
+	}
+	if neg && v.Kind() != constant.Unknown {
+		v = constant.UnaryOp(token.SUB, v, 0)
+	}
+
+	return v
+}
+
+type mndNumber struct {
+	expr  ast.Expr
+	value string
+	check string
+}
+
+type mndVisitor struct {
+	checks         map[string]bool
+	ignoredNumbers []constant.Value
+
+	parents []ast.Node // parents of the visited node, the last one is the direct parent
+	numbers []mndNumber
+}
+
+func (v *mndVisitor) visit(node ast.Node) bool {
+	if node == nil {
+		v.parents = v.parents[:len(v.parents)-1]
+		return false
+	}
+
+	if d, ok := node.(*ast.GenDecl); ok && d.Tok == token.CONST {
+		return false // numbers are named by constants
+	}
+
+	v.checkNumber(node)
+	v.parents = append(v.parents, node)
+	return true
+}
+
+func (v *mndVisitor) checkNumber(node ast.Node) {
+	expr, value := mndNumberExpr(node)
+	if expr == nil || len(v.parents) == 0 {
+		return
+	}
+
+	parent := v.parents[len(v.parents)-1]
+	if u, ok := parent.(*ast.UnaryExpr); ok && u.Op == token.SUB {
+		return // is checked as a negative number
+	}
+
+	check := mndCheckFor(expr, parent)
+	if check == "" || !v.checks[check] || v.isIgnored(value) {
+		return
+	}
+
+	v.numbers = append(v.numbers, mndNumber{
+		expr:  expr,
+		value: value,
+		check: check,
+	})
+}
+
+func (v mndVisitor) isIgnored(value string) bool {
+	n := parseMndNumber(value)
+	for _, ignored := range v.ignoredNumbers {
+		if constant.Compare(n, token.EQL, ignored) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mndNumberExpr returns the node and its code if it's a numeric literal or a negative numeric literal.
+func mndNumberExpr(node ast.Node) (ast.Expr, string) {
+	if u, ok := node.(*ast.UnaryExpr); ok && u.Op == token.SUB {
+		if lit, ok := u.X.(*ast.BasicLit); ok && (lit.Kind == token.INT || lit.Kind == token.FLOAT) {
+			return u, "-" + lit.Value
+		}
+	}
+
+	if lit, ok := node.(*ast.BasicLit); ok && (lit.Kind == token.INT || lit.Kind == token.FLOAT) {
+		return lit, lit.Value
+	}
+
+	return nil, ""
+}
+
+// mndCheckFor returns which check covers the number in the parent node, "" if no one.
+func mndCheckFor(expr ast.Expr, parent ast.Node) string {
+	switch p := parent.(type) {
+	case *ast.CallExpr:
+		if p.Fun != expr {
+			return mndCheckArgument
+		}
+	case *ast.AssignStmt, *ast.ValueSpec:
+		return mndCheckAssign
+	case *ast.CaseClause:
+		return mndCheckCase
+	case *ast.BinaryExpr:
+		switch p.Op {
+		case token.EQL, token.NEQ, token.LSS, token.GTR, token.LEQ, token.GEQ:
+			return mndCheckCondition
+		}
+		return mndCheckOperation
+	case *ast.IndexExpr:
+		if p.Index == expr {
+			return mndCheckIndex
+		}
+	case *ast.ReturnStmt:
+		return mndCheckReturn
+	}
+
+	return ""
+}
@@ -0,0 +1,141 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Musttag struct{}
+
+func (Musttag) Name() string {
+	return "musttag"
+}
+
+func (Musttag) Desc() string {
+	return "Enforces field tags in (un)marshaled structs"
+}
+
+func (lint Musttag) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	funcs := map[string]config.MusttagFunction{}
+	for _, fn := range lintCtx.Settings().Musttag.Functions {
+		funcs[fn.Name] = fn
+	}
+
+	return checkTypedPackages(ctx, lintCtx, lint.Name(), func(ctx context.Context, pkg *packages.Package) []result.Issue {
+		var res []result.Issue
+		for _, f := range pkg.Syntax {
+			ast.Inspect(f, func(node ast.Node) bool {
+				if ctx.Err() != nil {
+					return false // the package timeout is exceeded
+				}
+
+				call, ok := node.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+
+				fn, ok := funcs[musttagCalleeName(pkg, call)]
+				if !ok || fn.ArgPos < 0 || fn.ArgPos >= len(call.Args) {
+					return true
+				}
+
+				arg := call.Args[fn.ArgPos]
+				v := musttagChecker{tag: fn.Tag, visited: map[types.Type]bool{}}
+				v.check(pkg.TypesInfo.TypeOf(arg), "")
+				for _, field := range v.untagged {
+					res = append(res, result.Issue{
+						Pos: pkg.Fset.Position(arg.Pos()),
+						Text: fmt.Sprintf("the field %s should be annotated with the %s tag as it is passed to %s",
+							formatCode(field, lintCtx.Cfg), formatCode(fn.Tag, lintCtx.Cfg), formatCode(fn.Name, lintCtx.Cfg)),
+						FromLinter: lint.Name(),
+					})
+				}
+				return true
+			})
+		}
+		return res
+	}), nil
+}
+
+// musttagCalleeName returns the full name of the called function, e.g. encoding/json.Marshal
+// or (*encoding/json.Encoder).Encode.
+func musttagCalleeName(pkg *packages.Package, call *ast.CallExpr) string {
+	var id *ast.Ident
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		id = fun
+	case *ast.SelectorExpr:
+		id = fun.Sel
+	default:
+		return ""
+	}
+
+	fn, ok := pkg.TypesInfo.Uses[id].(*types.Func)
+	if !ok {
+		return ""
+	}
+
+	return fn.FullName()
+}
+
+type musttagChecker struct {
+	tag     string
+	visited map[types.Type]bool
+
+	untagged []string // like T.Field or T.Nested.Field
+}
+
+// check collects exported fields without the tag of the struct type and of struct types of its fields.
+func (c *musttagChecker) check(t types.Type, path string) {
+	if t == nil || c.visited[t] {
+		return
+	}
+	c.visited[t] = true
+
+	switch tt := t.(type) {
+	case *types.Pointer:
+		c.check(tt.Elem(), path)
+	case *types.Slice:
+		c.check(tt.Elem(), path)
+	case *types.Array:
+		c.check(tt.Elem(), path)
+	case *types.Map:
+		c.check(tt.Elem(), path)
+	case *types.Named:
+		if path == "" {
+			path = tt.Obj().Name()
+		}
+		c.check(tt.Underlying(), path)
+	case *types.Struct:
+		if path == "" {
+			path = "struct"
+		}
+		for i := 0; i < tt.NumFields(); i++ {
+			field := tt.Field(i)
+			if !field.Exported() && !field.Embedded() {
+				continue // ignored by encoders
+			}
+
+			_, tagged := reflect.StructTag(tt.Tag(i)).Lookup(c.tag)
+			if field.Embedded() && !tagged {
+				c.check(field.Type(), path) // fields of embedded structs are promoted
+				continue
+			}
+
+			fieldPath := path + "." + field.Name()
+			if !tagged {
+				c.untagged = append(c.untagged, fieldPath)
+			}
+			c.check(field.Type(), fieldPath)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package golinters
+
+import (
+	"context"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Nilnil struct{}
+
+func (Nilnil) Name() string {
+	return "nilnil"
+}
+
+func (Nilnil) Desc() string {
+	return "Checks that there is no simultaneous return of `nil` error and an invalid value"
+}
+
+func (lint Nilnil) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	checkedTypes := map[string]bool{}
+	for _, t := range lintCtx.Settings().Nilnil.CheckedTypes {
+		checkedTypes[t] = true
+	}
+
+	return checkTypedPackages(ctx, lintCtx, lint.Name(), func(ctx context.Context, pkg *packages.Package) []result.Issue {
+		var res []result.Issue
+		for _, f := range pkg.Syntax {
+			ast.Inspect(f, func(node ast.Node) bool {
+				if ctx.Err() != nil {
+					return false // the package timeout is exceeded
+				}
+
+				var ft *ast.FuncType
+				var body *ast.BlockStmt
+				switch n := node.(type) {
+				case *ast.FuncDecl:
+					ft, body = n.Type, n.Body
+				case *ast.FuncLit:
+					ft, body = n.Type, n.Body
+				default:
+					return true
+				}
+
+				if body != nil && nilnilIsChecked(pkg, ft, checkedTypes) {
+					res = append(res, lint.checkReturns(pkg, body)...)
+				}
+				return true
+			})
+		}
+		return res
+	}), nil
+}
+
+// nilnilIsChecked returns whether the function returns a value of the checked kind and an error.
+func nilnilIsChecked(pkg *packages.Package, ft *ast.FuncType, checkedTypes map[string]bool) bool {
+	if ft.Results == nil {
+		return false
+	}
+
+	var results []types.Type
+	for _, field := range ft.Results.List {
+		t := pkg.TypesInfo.TypeOf(field.Type)
+		if t == nil {
+			return false
+		}
+
+		n := len(field.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			results = append(results, t)
+		}
+	}
+
+	if len(results) != 2 || !types.Identical(results[1], errorType) {
+		return false
+	}
+
+	return checkedTypes[nilnilKind(results[0])]
+}
+
+// nilnilKind returns the kind of nillable types by names of the checked-types setting.
+func nilnilKind(t types.Type) string {
+	switch t.Underlying().(type) {
+	case *types.Pointer:
+		return "ptr"
+	case *types.Signature:
+		return "func"
+	case *types.Interface:
+		return "iface"
+	case *types.Map:
+		return "map"
+	case *types.Chan:
+		return "chan"
+	}
+
+	return ""
+}
+
+// checkReturns reports return nil, nil in the body, returns of nested functions are checked separately.
+func (lint Nilnil) checkReturns(pkg *packages.Package, body *ast.BlockStmt) []result.Issue {
+	var res []result.Issue
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			if len(n.Results) == 2 && pkg.TypesInfo.Types[n.Results[0]].IsNil() && pkg.TypesInfo.Types[n.Results[1]].IsNil() {
+				res = append(res, result.Issue{
+					Pos:        pkg.Fset.Position(n.Pos()),
+					Text:       "return both the `nil` error and invalid value: use a sentinel error instead",
+					FromLinter: lint.Name(),
+				})
+			}
+		}
+		return true
+	})
+
+	return res
+}
@@ -0,0 +1,116 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Nolintlint struct{}
+
+func (Nolintlint) Name() string {
+	return "nolintlint"
+}
+
+func (Nolintlint) Desc() string {
+	return "Reports ill-formed or insufficiently explained //nolint directives"
+}
+
+var nolintLintersRe = regexp.MustCompile(`^[\w-]+(,[\w-]+)*$`)
+
+func (lint Nolintlint) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	settings := &lintCtx.Settings().Nolintlint
+
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		for _, g := range f.F.Comments {
+			for _, c := range g.List {
+				for _, text := range lint.checkComment(c.Text, settings) {
+					res = append(res, result.Issue{
+						Pos:        f.Fset.Position(c.Pos()),
+						Text:       text,
+						FromLinter: lint.Name(),
+					})
+				}
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// checkComment returns problems of the comment if it's a nolint directive.
+// The machine-readable format of the directive is `//nolint[:linter1,linter2] [// explanation]`.
+func (lint Nolintlint) checkComment(text string, settings *config.NolintlintSettings) []string {
+	if !strings.HasPrefix(text, "//") {
+		return nil // /* nolint */ isn't a directive
+	}
+
+	body := strings.TrimLeft(text[2:], " \t")
+	hasLeadingSpace := len(body) != len(text)-2
+	if !strings.HasPrefix(body, "nolint") {
+		return nil
+	}
+
+	rest := body[len("nolint"):]
+	if rest != "" && !strings.ContainsAny(rest[:1], ": \t/") {
+		return nil // e.g. nolintlint
+	}
+
+	directive := strings.TrimSpace(text)
+
+	var explanation string
+	if i := strings.Index(rest, "//"); i != -1 {
+		rest, explanation = rest[:i], strings.TrimSpace(rest[i+2:])
+	}
+
+	var linters string
+	hasInnerSpaces := false
+	if strings.HasPrefix(rest, ":") {
+		rawLinters := strings.TrimRight(rest[1:], " \t")
+		var names []string
+		for _, name := range strings.Split(rawLinters, ",") {
+			names = append(names, strings.TrimSpace(name))
+		}
+
+		linters = strings.Join(names, ",")
+		if !nolintLintersRe.MatchString(linters) {
+			return []string{lint.malformedText(directive)}
+		}
+		hasInnerSpaces = linters != rawLinters
+	} else if strings.TrimSpace(rest) != "" {
+		return []string{lint.malformedText(directive)}
+	}
+
+	machineDirective := "//nolint"
+	if linters != "" {
+		machineDirective += ":" + linters
+	}
+
+	var ret []string
+	if (hasLeadingSpace || hasInnerSpaces) && !settings.AllowLeadingSpace {
+		ret = append(ret, fmt.Sprintf("directive `%s` should be written without spaces as `%s`",
+			directive, machineDirective))
+	}
+
+	if settings.RequireSpecific && linters == "" {
+		ret = append(ret, fmt.Sprintf("directive `%s` should mention specific linter such as `//nolint:my-linter`",
+			directive))
+	}
+
+	if settings.RequireExplanation && explanation == "" {
+		ret = append(ret, fmt.Sprintf("directive `%s` should provide explanation such as `%s // this is why`",
+			directive, machineDirective))
+	}
+
+	return ret
+}
+
+func (Nolintlint) malformedText(directive string) string {
+	return fmt.Sprintf("directive `%s` should match `//nolint[:<comma-separated-linters>] [// <explanation>]`", directive)
+}
@@ -0,0 +1,45 @@
+package golinters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+)
+
+func TestNolintlintRequireExplanation(t *testing.T) {
+	settings := &config.NolintlintSettings{RequireExplanation: true, AllowLeadingSpace: true}
+
+	assert.Equal(t, []string{"directive `//nolint` should provide explanation such as `//nolint // this is why`"},
+		Nolintlint{}.checkComment("//nolint", settings))
+	assert.Equal(t, []string{"directive `// nolint:errcheck //` should provide explanation such as " +
+		"`//nolint:errcheck // this is why`"}, Nolintlint{}.checkComment("// nolint:errcheck //", settings))
+
+	assert.Empty(t, Nolintlint{}.checkComment("//nolint:errcheck // close error is irrelevant here", settings))
+	assert.Empty(t, Nolintlint{}.checkComment("// just a comment about nolint", settings))
+	assert.Empty(t, Nolintlint{}.checkComment("//nolintlint is a linter", settings))
+}
+
+func TestNolintlintRequireSpecific(t *testing.T) {
+	settings := &config.NolintlintSettings{RequireSpecific: true, AllowLeadingSpace: true}
+
+	assert.Equal(t, []string{"directive `//nolint // reason` should mention specific linter such as `//nolint:my-linter`"},
+		Nolintlint{}.checkComment("//nolint // reason", settings))
+	assert.Empty(t, Nolintlint{}.checkComment("//nolint:gocyclo,lll // reason", settings))
+}
+
+func TestNolintlintMachineFormat(t *testing.T) {
+	settings := &config.NolintlintSettings{}
+
+	assert.Equal(t, []string{"directive `// nolint:gofmt` should be written without spaces as `//nolint:gofmt`"},
+		Nolintlint{}.checkComment("// nolint:gofmt", settings))
+	assert.Equal(t, []string{"directive `//nolint: gofmt, govet` should be written without spaces as `//nolint:gofmt,govet`"},
+		Nolintlint{}.checkComment("//nolint: gofmt, govet", settings))
+	assert.Equal(t, []string{"directive `//nolint:gofmt reason` should match " +
+		"`//nolint[:<comma-separated-linters>] [// <explanation>]`"},
+		Nolintlint{}.checkComment("//nolint:gofmt reason", settings))
+
+	assert.Empty(t, Nolintlint{}.checkComment("//nolint:gofmt // reason", settings))
+	assert.Empty(t, Nolintlint{}.checkComment("// nolint: gofmt", &config.NolintlintSettings{AllowLeadingSpace: true}))
+}
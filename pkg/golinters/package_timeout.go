@@ -0,0 +1,91 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/token"
+	"time"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// packageCheck checks the package: it must stop as soon as ctx is done.
+type packageCheck func(ctx context.Context, pkg *packages.Package) []result.Issue
+
+// checkTypedPackages runs the check of the linter for every package with type info one by one.
+// A package checked longer than run.package-timeout is skipped with a warning issue.
+func checkTypedPackages(ctx context.Context, lintCtx *linter.Context, linterName string, check packageCheck) []result.Issue {
+	return checkPackagesWithTimeout(ctx, lintCtx.Packages, lintCtx.Cfg.Run.PackageTimeout, linterName, check)
+}
+
+func checkPackagesWithTimeout(ctx context.Context, pkgs []*packages.Package, timeout time.Duration, linterName string,
+	check packageCheck) []result.Issue {
+	var res []result.Issue
+	for _, pkg := range pkgs {
+		if pkg.IllTyped || pkg.TypesInfo == nil {
+			continue
+		}
+
+		if timeout == 0 {
+			res = append(res, check(ctx, pkg)...)
+			continue
+		}
+
+		issues, ok := checkPackageWithTimeout(ctx, pkg, timeout, check)
+		if ok {
+			res = append(res, issues...)
+			continue
+		}
+		if ctx.Err() != nil {
+			return res // the deadline of the whole run is exceeded
+		}
+
+		if len(pkg.GoFiles) != 0 {
+			res = append(res, result.Issue{
+				Pos:        token.Position{Filename: pkg.GoFiles[0], Line: 1},
+				Text:       fmt.Sprintf("package %s was skipped: checking it took longer than %s", pkg.PkgPath, timeout),
+				Severity:   result.SeverityWarning,
+				FromLinter: linterName,
+			})
+		}
+	}
+
+	return res
+}
+
+type packageCheckResult struct {
+	issues    []result.Issue
+	panicData interface{}
+}
+
+// checkPackageWithTimeout cancels the context of the check after the timeout: the check stops in the background
+// and its issues are dropped. Panics are rethrown to be recovered by the runner of linters.
+func checkPackageWithTimeout(ctx context.Context, pkg *packages.Package, timeout time.Duration,
+	check packageCheck) ([]result.Issue, bool) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	done := make(chan packageCheckResult, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- packageCheckResult{panicData: r}
+			}
+		}()
+
+		done <- packageCheckResult{issues: check(ctx, pkg)}
+	}()
+
+	select {
+	case res := <-done:
+		if res.panicData != nil {
+			panic(res.panicData)
+		}
+		return res.issues, true
+	case <-ctx.Done():
+		return nil, false
+	}
+}
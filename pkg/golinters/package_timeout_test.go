@@ -0,0 +1,86 @@
+package golinters
+
+import (
+	"context"
+	"go/token"
+	"go/types"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newTimeoutTestPackage(path string) *packages.Package {
+	return &packages.Package{
+		PkgPath:   path,
+		GoFiles:   []string{path + "/a.go"},
+		TypesInfo: &types.Info{},
+	}
+}
+
+func TestCheckPackagesWithTimeout(t *testing.T) {
+	stopped := make(chan struct{})
+
+	pkgs := []*packages.Package{
+		newTimeoutTestPackage("a"),
+		newTimeoutTestPackage("slow"),
+		newTimeoutTestPackage("b"),
+	}
+	check := func(ctx context.Context, pkg *packages.Package) []result.Issue {
+		if pkg.PkgPath == "slow" {
+			for ctx.Err() == nil {
+				time.Sleep(time.Millisecond)
+			}
+			close(stopped)
+		}
+		return []result.Issue{{
+			Pos:        token.Position{Filename: pkg.GoFiles[0], Line: 2},
+			Text:       "issue in " + pkg.PkgPath,
+			FromLinter: "fake",
+		}}
+	}
+
+	const timeout = 50 * time.Millisecond
+	startedAt := time.Now()
+	issues := checkPackagesWithTimeout(context.Background(), pkgs, timeout, "fake", check)
+	assert.True(t, time.Since(startedAt) < time.Second, "the slow package must be skipped at its deadline")
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("the check of the slow package must be stopped after its deadline")
+	}
+
+	assert.Equal(t, []result.Issue{
+		{
+			Pos:        token.Position{Filename: "a/a.go", Line: 2},
+			Text:       "issue in a",
+			FromLinter: "fake",
+		},
+		{
+			Pos:        token.Position{Filename: "slow/a.go", Line: 1},
+			Text:       "package slow was skipped: checking it took longer than 50ms",
+			Severity:   result.SeverityWarning,
+			FromLinter: "fake",
+		},
+		{
+			Pos:        token.Position{Filename: "b/a.go", Line: 2},
+			Text:       "issue in b",
+			FromLinter: "fake",
+		},
+	}, issues)
+}
+
+func TestCheckPackagesWithTimeoutPanic(t *testing.T) {
+	check := func(_ context.Context, pkg *packages.Package) []result.Issue {
+		panic("bad package")
+	}
+
+	assert.PanicsWithValue(t, "bad package", func() {
+		checkPackagesWithTimeout(context.Background(), []*packages.Package{newTimeoutTestPackage("a")},
+			time.Second, "fake", check)
+	})
+}
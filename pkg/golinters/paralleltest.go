@@ -0,0 +1,148 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Paralleltest struct{}
+
+func (Paralleltest) Name() string {
+	return "paralleltest"
+}
+
+func (Paralleltest) Desc() string {
+	return "Detects missing usage of t.Parallel() in tests and in subtests of parallel tests"
+}
+
+func (lint Paralleltest) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	ignoreMissing := lintCtx.Settings().Paralleltest.IgnoreMissing
+
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		testingName := getImportName(f.F, "testing")
+		if testingName == "" {
+			continue
+		}
+
+		for _, decl := range f.F.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || fn.Body == nil || !strings.HasPrefix(fn.Name.Name, "Test") {
+				continue
+			}
+
+			t := testingTParam(fn.Type, testingName)
+			if t == "" {
+				continue
+			}
+
+			v := paralleltestVisitor{fset: f.Fset, testName: fn.Name.Name, linterName: lint.Name()}
+			if !v.checkTest(fn.Body, t) && !ignoreMissing {
+				res = append(res, result.Issue{
+					Pos:        f.Fset.Position(fn.Name.Pos()),
+					Text:       fmt.Sprintf("Function %s missing the call to method parallel", fn.Name.Name),
+					FromLinter: lint.Name(),
+				})
+			}
+			res = append(res, v.issues...)
+		}
+	}
+
+	return res, nil
+}
+
+// testingTParam returns the name of the only *testing.T parameter of the function, "" if there is no one.
+func testingTParam(ft *ast.FuncType, testingName string) string {
+	if ft.Params == nil || len(ft.Params.List) != 1 || len(ft.Params.List[0].Names) != 1 {
+		return ""
+	}
+
+	param := ft.Params.List[0]
+	star, ok := param.Type.(*ast.StarExpr)
+	if !ok || !isPkgSelector(star.X, testingName, "T") || param.Names[0].Name == "_" {
+		return ""
+	}
+
+	return param.Names[0].Name
+}
+
+type paralleltestVisitor struct {
+	fset       *token.FileSet
+	testName   string
+	linterName string
+
+	issues []result.Issue
+}
+
+// checkTest returns whether the test with the body calls t.Parallel(). If it does it reports
+// subtests passed to t.Run not calling it: they're run sequentially unlike their parent.
+func (v *paralleltestVisitor) checkTest(body *ast.BlockStmt, t string) bool {
+	isParallel := false
+	var subtests []*ast.FuncLit
+	var subtestCalls []*ast.CallExpr
+
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.FuncLit:
+			return false // t can be shadowed, subtests are processed below
+		case *ast.CallExpr:
+			sel, ok := n.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			if id, ok := sel.X.(*ast.Ident); !ok || id.Name != t {
+				return true
+			}
+
+			switch sel.Sel.Name {
+			case "Parallel":
+				isParallel = true
+			case "Run":
+				if len(n.Args) == 2 {
+					if lit, ok := n.Args[1].(*ast.FuncLit); ok {
+						subtests = append(subtests, lit)
+						subtestCalls = append(subtestCalls, n)
+					}
+				}
+			}
+		}
+		return true
+	})
+
+	for i, lit := range subtests {
+		subT := testingTParamOfLit(lit)
+		if subT == "" {
+			continue
+		}
+
+		if !v.checkTest(lit.Body, subT) && isParallel {
+			v.issues = append(v.issues, result.Issue{
+				Pos:        v.fset.Position(subtestCalls[i].Pos()),
+				Text:       fmt.Sprintf("Function %s missing the call to method parallel in the test run", v.testName),
+				FromLinter: v.linterName,
+			})
+		}
+	}
+
+	return isParallel
+}
+
+func testingTParamOfLit(lit *ast.FuncLit) string {
+	params := lit.Type.Params
+	if params == nil || len(params.List) != 1 || len(params.List[0].Names) != 1 {
+		return ""
+	}
+
+	name := params.List[0].Names[0].Name
+	if name == "_" {
+		return ""
+	}
+
+	return name
+}
@@ -0,0 +1,196 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Promlinter struct{}
+
+func (Promlinter) Name() string {
+	return "promlinter"
+}
+
+func (Promlinter) Desc() string {
+	return "Checks names and help texts of Prometheus metrics"
+}
+
+const (
+	prometheusPkgPath = "github.com/prometheus/client_golang/prometheus"
+	promautoPkgPath   = "github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// promMetricConstructors maps constructors of metrics to whether the metric is a counter
+var promMetricConstructors = map[string]bool{
+	"NewCounter":      true,
+	"NewCounterVec":   true,
+	"NewGauge":        false,
+	"NewGaugeVec":     false,
+	"NewHistogram":    false,
+	"NewHistogramVec": false,
+	"NewSummary":      false,
+	"NewSummaryVec":   false,
+}
+
+// promUnitSuffixes are base units recommended by https://prometheus.io/docs/practices/naming/
+var promUnitSuffixes = []string{
+	"seconds", "bytes", "bits", "ratio", "percent", "celsius", "meters", "volts", "amperes",
+	"joules", "grams", "hertz", "info",
+}
+
+var promSnakeCaseRe = regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`)
+
+func (lint Promlinter) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		pkgNames := []string{getImportName(f.F, prometheusPkgPath), getImportName(f.F, promautoPkgPath)}
+		if pkgNames[0] == "" && pkgNames[1] == "" {
+			continue
+		}
+
+		ast.Inspect(f.F, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+
+			for constructor, isCounter := range promMetricConstructors {
+				if isPkgSelector(call.Fun, pkgNames[0], constructor) || isPkgSelector(call.Fun, pkgNames[1], constructor) {
+					res = append(res, lint.checkOpts(call, isCounter, f.Fset)...)
+					break
+				}
+			}
+			return true
+		})
+	}
+
+	return res, nil
+}
+
+// checkOpts checks options of the metric: the first argument of the constructor.
+func (lint Promlinter) checkOpts(call *ast.CallExpr, isCounter bool, fset *token.FileSet) []result.Issue {
+	opts := call.Args[0]
+	if u, ok := opts.(*ast.UnaryExpr); ok && u.Op == token.AND {
+		opts = u.X
+	}
+
+	lit, ok := opts.(*ast.CompositeLit)
+	if !ok {
+		return nil // options built elsewhere
+	}
+
+	fields := map[string]ast.Expr{}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if key, ok := kv.Key.(*ast.Ident); ok {
+			fields[key.Name] = kv.Value
+		}
+	}
+
+	var res []result.Issue
+	report := func(node ast.Node, format string, args ...interface{}) {
+		res = append(res, result.Issue{
+			Pos:        fset.Position(node.Pos()),
+			Text:       fmt.Sprintf(format, args...),
+			FromLinter: lint.Name(),
+		})
+	}
+
+	name, nameIsConst := promFullName(fields)
+	if nameIsConst {
+		if text := promNameProblem(name, isCounter); text != "" {
+			report(fields["Name"], "%s", text)
+		}
+	}
+
+	metric := "metric"
+	if nameIsConst {
+		metric = fmt.Sprintf("metric %q", name)
+	}
+
+	help, ok := fields["Help"]
+	if !ok {
+		report(lit, "%s should have help text", metric)
+	} else if s, isConst := stringLitValue(help); isConst && strings.TrimSpace(s) == "" {
+		report(help, "%s should have non-empty help text", metric)
+	}
+
+	return res
+}
+
+// promFullName returns the name of the metric with namespace and subsystem if all of them are literals.
+func promFullName(fields map[string]ast.Expr) (string, bool) {
+	if fields["Name"] == nil {
+		return "", false
+	}
+
+	var parts []string
+	for _, field := range []string{"Namespace", "Subsystem", "Name"} {
+		e, ok := fields[field]
+		if !ok {
+			continue
+		}
+
+		s, isConst := stringLitValue(e)
+		if !isConst {
+			return "", false
+		}
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+
+	return strings.Join(parts, "_"), true
+}
+
+func promNameProblem(name string, isCounter bool) string {
+	if !promSnakeCaseRe.MatchString(name) {
+		return fmt.Sprintf("metric name %q should be snake_case", name)
+	}
+
+	hasTotalSuffix := strings.HasSuffix(name, "_total")
+	if isCounter {
+		if !hasTotalSuffix {
+			return fmt.Sprintf("counter metric %q should have %q suffix", name, "_total")
+		}
+		return ""
+	}
+
+	if hasTotalSuffix {
+		return fmt.Sprintf("non-counter metric %q should not have %q suffix", name, "_total")
+	}
+
+	for _, unit := range promUnitSuffixes {
+		if strings.HasSuffix(name, "_"+unit) {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf("metric name %q should have a unit suffix such as %s", name,
+		strings.Join(promUnitSuffixes[:3], ", "))
+}
+
+func stringLitValue(e ast.Expr) (string, bool) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+
+	return s, true
+}
@@ -0,0 +1,195 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Protogetter struct{}
+
+func (Protogetter) Name() string {
+	return "protogetter"
+}
+
+func (Protogetter) Desc() string {
+	return "Reports direct reads of protobuf messages fields instead of using getters"
+}
+
+func (lint Protogetter) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	return checkTypedPackages(ctx, lintCtx, lint.Name(), func(ctx context.Context, pkg *packages.Package) []result.Issue {
+		var res []result.Issue
+		for _, f := range pkg.Syntax {
+			res = append(res, lint.checkFile(ctx, pkg, f, lintCtx.Cfg)...)
+		}
+		return res
+	}), nil
+}
+
+func (lint Protogetter) checkFile(ctx context.Context, pkg *packages.Package, f *ast.File, cfg *config.Config) []result.Issue {
+	writes := protogetterWrites(f)
+	inner := map[*ast.SelectorExpr]bool{}
+
+	// rewrite returns the expression with getters instead of proto fields accesses in the selector chain,
+	// collects text edits for them and marks selectors of the chain as reported.
+	var rewrite func(e ast.Expr, edits *[]result.TextEdit) string
+	rewrite = func(e ast.Expr, edits *[]result.TextEdit) string {
+		sel, ok := e.(*ast.SelectorExpr)
+		if !ok || writes[sel] {
+			return types.ExprString(e)
+		}
+
+		inner[sel] = true
+		x := rewrite(sel.X, edits)
+
+		getter := protoFieldGetter(pkg.TypesInfo, sel)
+		if getter == "" {
+			return x + "." + sel.Sel.Name
+		}
+
+		*edits = append(*edits, result.TextEdit{
+			Pos:     pkg.Fset.Position(sel.Sel.Pos()).Offset,
+			End:     pkg.Fset.Position(sel.Sel.End()).Offset,
+			NewText: getter + "()",
+		})
+		return x + "." + getter + "()"
+	}
+
+	var res []result.Issue
+	ast.Inspect(f, func(node ast.Node) bool {
+		if ctx.Err() != nil {
+			return false // the package timeout is exceeded
+		}
+
+		if fn, ok := node.(*ast.FuncDecl); ok && isProtoMessageMethod(pkg.TypesInfo, fn) {
+			return false // getters and other methods of messages access fields directly
+		}
+
+		sel, ok := node.(*ast.SelectorExpr)
+		if !ok || writes[sel] || inner[sel] {
+			return true
+		}
+
+		if protoFieldGetter(pkg.TypesInfo, sel) == "" {
+			return true
+		}
+
+		// Report the whole selector chain once: `u.Manager.Name` becomes `u.GetManager().GetName()`.
+		var edits []result.TextEdit
+		getterExpr := rewrite(sel, &edits)
+		res = append(res, result.Issue{
+			Pos:        pkg.Fset.Position(sel.Pos()),
+			Text:       fmt.Sprintf("avoid direct access to proto field %s, use %s instead", formatCode(types.ExprString(sel), cfg), formatCode(getterExpr, cfg)),
+			FromLinter: lint.Name(),
+			SuggestedFixes: []result.SuggestedFix{{
+				Message:    "Use getters",
+				TextEdits:  edits,
+				Confidence: 0.9, // getters return zero values for nil messages instead of panicking
+			}},
+		})
+		return true
+	})
+
+	return res
+}
+
+// protogetterWrites returns selectors which are assigned, incremented or addressed: getters can't replace them.
+func protogetterWrites(f *ast.File) map[*ast.SelectorExpr]bool {
+	writes := map[*ast.SelectorExpr]bool{}
+	mark := func(e ast.Expr) {
+		if sel, ok := unparen(e).(*ast.SelectorExpr); ok {
+			writes[sel] = true
+		}
+	}
+
+	ast.Inspect(f, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.AssignStmt:
+			for _, e := range n.Lhs {
+				mark(e)
+			}
+		case *ast.IncDecStmt:
+			mark(n.X)
+		case *ast.UnaryExpr:
+			if n.Op == token.AND {
+				mark(n.X)
+			}
+		case *ast.RangeStmt:
+			if n.Key != nil {
+				mark(n.Key)
+			}
+			if n.Value != nil {
+				mark(n.Value)
+			}
+		}
+		return true
+	})
+
+	return writes
+}
+
+// protoFieldGetter returns the name of the getter of the field selected from a pointer to a proto message.
+func protoFieldGetter(info *types.Info, sel *ast.SelectorExpr) string {
+	selection := info.Selections[sel]
+	if selection == nil || selection.Kind() != types.FieldVal || len(selection.Index()) != 1 {
+		return "" // fields of embedded structs have no getters
+	}
+
+	ptr, ok := selection.Recv().(*types.Pointer)
+	if !ok || !isProtoMessage(ptr) {
+		return ""
+	}
+
+	field := selection.Obj().(*types.Var)
+	getter := "Get" + field.Name()
+	obj, _, _ := types.LookupFieldOrMethod(ptr, false, field.Pkg(), getter)
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return ""
+	}
+
+	sig := fn.Type().(*types.Signature)
+	if sig.Params().Len() != 0 || sig.Results().Len() != 1 || !types.Identical(sig.Results().At(0).Type(), field.Type()) {
+		return ""
+	}
+
+	return getter
+}
+
+func isProtoMessageMethod(info *types.Info, fn *ast.FuncDecl) bool {
+	if fn.Recv == nil || len(fn.Recv.List) != 1 {
+		return false
+	}
+
+	t := info.TypeOf(fn.Recv.List[0].Type)
+	if t == nil {
+		return false
+	}
+	if _, ok := t.(*types.Pointer); !ok {
+		t = types.NewPointer(t)
+	}
+
+	return isProtoMessage(t)
+}
+
+// isProtoMessage checks the type is a message generated by protoc-gen-go: the APIv2 one has ProtoReflect,
+// the APIv1 one has ProtoMessage.
+func isProtoMessage(t types.Type) bool {
+	for _, name := range []string{"ProtoReflect", "ProtoMessage"} {
+		if obj, _, _ := types.LookupFieldOrMethod(t, false, nil, name); obj != nil {
+			if _, ok := obj.(*types.Func); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
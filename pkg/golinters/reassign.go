@@ -0,0 +1,85 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Reassign struct{}
+
+func (Reassign) Name() string {
+	return "reassign"
+}
+
+func (Reassign) Desc() string {
+	return "Checks that package variables are not reassigned"
+}
+
+func (lint Reassign) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	patterns := lintCtx.Settings().Reassign.Patterns
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+
+	re, err := regexp.Compile(fmt.Sprintf("^(%s)$", strings.Join(patterns, "|")))
+	if err != nil {
+		return nil, fmt.Errorf("can't compile reassign patterns %q: %s", patterns, err)
+	}
+
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		res = append(res, lint.checkFile(f.F, f.Fset, re)...)
+	}
+
+	return res, nil
+}
+
+func (lint Reassign) checkFile(f *ast.File, fset *token.FileSet, re *regexp.Regexp) []result.Issue {
+	imported := map[string]bool{}
+	for _, imp := range f.Imports {
+		path := strings.Trim(imp.Path.Value, `"`)
+		if name := getImportName(f, path); name != "" && name != "." {
+			imported[name] = true
+		}
+	}
+	if len(imported) == 0 {
+		return nil
+	}
+
+	var res []result.Issue
+	ast.Inspect(f, func(node ast.Node) bool {
+		assign, ok := node.(*ast.AssignStmt)
+		if !ok || assign.Tok == token.DEFINE {
+			return true
+		}
+
+		for _, lhs := range assign.Lhs {
+			sel, ok := lhs.(*ast.SelectorExpr)
+			if !ok {
+				continue
+			}
+
+			// package names aren't resolved by the parser, so a resolved identifier is a local variable
+			pkg, ok := sel.X.(*ast.Ident)
+			if !ok || pkg.Obj != nil || !imported[pkg.Name] || !re.MatchString(sel.Sel.Name) {
+				continue
+			}
+
+			res = append(res, result.Issue{
+				Pos:        fset.Position(lhs.Pos()),
+				Text:       fmt.Sprintf("reassigning variable %s in other package %s", sel.Sel.Name, pkg.Name),
+				FromLinter: lint.Name(),
+			})
+		}
+		return true
+	})
+
+	return res
+}
@@ -51,7 +51,7 @@ type Node struct {
 // Visit method is invoked for each node encountered by Walk.
 // If the result visitor w is not nil, Walk visits each of the children
 // of node with the visitor w, followed by a call of w.Visit(nil).
-//nolint:gocyclo,gocritic
+//nolint:gocyclo,gocritic,gocognit
 func (f *Node) Visit(node ast.Node) ast.Visitor {
 	switch typedNode := node.(type) {
 	case *ast.ForStmt:
@@ -0,0 +1,252 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ssa"
+	"golang.org/x/tools/go/ssa/ssautil"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Sqlclosecheck struct{}
+
+func (Sqlclosecheck) Name() string {
+	return "sqlclosecheck"
+}
+
+func (Sqlclosecheck) Desc() string {
+	return "Checks that sql.Rows, sql.Stmt and sql.Row are closed"
+}
+
+// sqlCloseMethods maps types of database/sql needing closing to methods closing them:
+// sql.Row has no Close method, its rows are closed by Scan
+var sqlCloseMethods = map[string]string{
+	"Rows": "Close",
+	"Stmt": "Close",
+	"Row":  "Scan",
+}
+
+func (lint Sqlclosecheck) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	prog := lintCtx.SSAProgram
+	lintedPkgs := map[*ssa.Package]bool{}
+	for _, pkg := range lintCtx.Packages {
+		if pkg.IllTyped || pkg.Types == nil {
+			continue
+		}
+		if ssaPkg := prog.Package(pkg.Types); ssaPkg != nil {
+			lintedPkgs[ssaPkg] = true
+		}
+	}
+
+	var res []result.Issue
+	for fn := range ssautil.AllFunctions(prog) {
+		if !lintedPkgs[fn.Pkg] || fn.Synthetic != "" || len(fn.Blocks) == 0 {
+			continue
+		}
+
+		for _, b := range fn.Blocks {
+			for _, instr := range b.Instrs {
+				call, ok := instr.(*ssa.Call)
+				if !ok {
+					continue
+				}
+
+				v, typeName := sqlResourceOfCall(call)
+				if v == nil || sqlResourceIsClosed(v, call, typeName) {
+					continue
+				}
+
+				res = append(res, result.Issue{
+					Pos:        prog.Fset.Position(call.Pos()),
+					Text:       fmt.Sprintf("sql.%s must be closed by %s", typeName, sqlCloseMethods[typeName]),
+					FromLinter: lint.Name(),
+				})
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// sqlResourceOfCall returns the value of *sql.Rows, *sql.Stmt or *sql.Row returned by the call,
+// e.g. by db.Query returning (*sql.Rows, error).
+func sqlResourceOfCall(call *ssa.Call) (ssa.Value, string) {
+	if name := sqlResourceTypeName(call.Type()); name != "" {
+		return call, name
+	}
+
+	tuple, ok := call.Type().(*types.Tuple)
+	if !ok {
+		return nil, ""
+	}
+
+	for _, ref := range *call.Referrers() {
+		if e, ok := ref.(*ssa.Extract); ok {
+			if name := sqlResourceTypeName(tuple.At(e.Index).Type()); name != "" {
+				return e, name
+			}
+		}
+	}
+
+	return nil, ""
+}
+
+func sqlResourceTypeName(t types.Type) string {
+	ptr, ok := t.(*types.Pointer)
+	if !ok {
+		return ""
+	}
+
+	named, ok := ptr.Elem().(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != "database/sql" {
+		return ""
+	}
+
+	if _, ok := sqlCloseMethods[named.Obj().Name()]; !ok {
+		return ""
+	}
+
+	return named.Obj().Name()
+}
+
+// sqlMethodOf returns the name of the method of the sql type called by the call.
+func sqlMethodOf(call *ssa.CallCommon, typeName string) (string, bool) {
+	callee := call.StaticCallee()
+	if callee == nil || callee.Signature.Recv() == nil {
+		return "", false
+	}
+
+	if sqlResourceTypeName(callee.Signature.Recv().Type()) != typeName {
+		return "", false
+	}
+
+	return callee.Name(), true
+}
+
+// sqlResourceIsClosed checks the value is closed by a deferred close or by a close on all paths to returns.
+// Values passed to other functions, returned or stored are closed elsewhere.
+func sqlResourceIsClosed(v ssa.Value, created *ssa.Call, typeName string) bool {
+	closeMethod := sqlCloseMethods[typeName]
+	closingBlocks := map[*ssa.BasicBlock]bool{}
+
+	for _, ref := range *v.Referrers() {
+		var call *ssa.CallCommon
+		switch ref := ref.(type) {
+		case *ssa.Call:
+			call = ref.Common()
+		case *ssa.Defer:
+			call = ref.Common()
+		case *ssa.DebugRef:
+			continue
+		case *ssa.If, *ssa.BinOp:
+			continue // comparison with nil
+		default:
+			return true // escapes: returned, stored, captured by closure or converted
+		}
+
+		method, isMethod := sqlMethodOf(call, typeName)
+		if !isMethod || len(call.Args) == 0 || call.Args[0] != v {
+			return true // passed to another function
+		}
+
+		if method != closeMethod {
+			continue
+		}
+		if _, isDefer := ref.(*ssa.Defer); isDefer {
+			return true
+		}
+		closingBlocks[ref.Block()] = true
+	}
+
+	return !sqlHasUnclosedPath(created, closingBlocks)
+}
+
+// sqlHasUnclosedPath returns whether there is a path from the creation of the resource to a return
+// not passing closing blocks. Branches where the error returned with the resource isn't nil are skipped:
+// the resource is nil there.
+func sqlHasUnclosedPath(created *ssa.Call, closingBlocks map[*ssa.BasicBlock]bool) bool {
+	errValue := sqlErrorOfCall(created)
+
+	visited := map[*ssa.BasicBlock]bool{}
+	var visit func(b *ssa.BasicBlock) bool
+	visit = func(b *ssa.BasicBlock) bool {
+		if visited[b] {
+			return false
+		}
+		visited[b] = true
+
+		if closingBlocks[b] {
+			return false // the close is after the creation in the creation block too
+		}
+		if len(b.Instrs) == 0 {
+			return false
+		}
+
+		switch last := b.Instrs[len(b.Instrs)-1].(type) {
+		case *ssa.Return:
+			return true
+		case *ssa.Panic:
+			return false
+		case *ssa.If:
+			if errValue != nil {
+				if succ := sqlNilErrorBranch(last, errValue); succ != nil {
+					return visit(succ)
+				}
+			}
+		}
+
+		for _, succ := range b.Succs {
+			if visit(succ) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return visit(created.Block())
+}
+
+func sqlErrorOfCall(call *ssa.Call) ssa.Value {
+	tuple, ok := call.Type().(*types.Tuple)
+	if !ok {
+		return nil
+	}
+
+	for _, ref := range *call.Referrers() {
+		if e, ok := ref.(*ssa.Extract); ok && types.Identical(tuple.At(e.Index).Type(), types.Universe.Lookup("error").Type()) {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// sqlNilErrorBranch returns the successor of the if where the error is nil, nil if the if doesn't check the error.
+func sqlNilErrorBranch(i *ssa.If, errValue ssa.Value) *ssa.BasicBlock {
+	cond, ok := i.Cond.(*ssa.BinOp)
+	if !ok || (cond.X != errValue && cond.Y != errValue) {
+		return nil
+	}
+
+	other := cond.Y
+	if cond.Y == errValue {
+		other = cond.X
+	}
+	if c, ok := other.(*ssa.Const); !ok || !c.IsNil() {
+		return nil
+	}
+
+	switch cond.Op {
+	case token.NEQ:
+		return i.Block().Succs[1]
+	case token.EQL:
+		return i.Block().Succs[0]
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Tagliatelle struct{}
+
+func (Tagliatelle) Name() string {
+	return "tagliatelle"
+}
+
+func (Tagliatelle) Desc() string {
+	return "Checks the case of names in struct tags"
+}
+
+// tagliatelleCases converts words of a name to the case
+var tagliatelleCases = map[string]func(words []string) string{
+	"camel": func(words []string) string {
+		return strings.ToLower(words[0]) + joinTitleWords(words[1:], false)
+	},
+	"pascal": func(words []string) string {
+		return joinTitleWords(words, false)
+	},
+	"goCamel": func(words []string) string {
+		return strings.ToLower(words[0]) + joinTitleWords(words[1:], true)
+	},
+	"goPascal": func(words []string) string {
+		return joinTitleWords(words, true)
+	},
+	"snake": func(words []string) string {
+		return strings.ToLower(strings.Join(words, "_"))
+	},
+	"upperSnake": func(words []string) string {
+		return strings.ToUpper(strings.Join(words, "_"))
+	},
+	"kebab": func(words []string) string {
+		return strings.ToLower(strings.Join(words, "-"))
+	},
+}
+
+// tagliatelleInitialisms are written in upper case by goCamel and goPascal cases
+var tagliatelleInitialisms = map[string]bool{
+	"API": true, "ASCII": true, "CPU": true, "CSS": true, "DNS": true, "EOF": true, "GUID": true,
+	"HTML": true, "HTTP": true, "HTTPS": true, "ID": true, "IP": true, "JSON": true, "RPC": true,
+	"SQL": true, "SSH": true, "TCP": true, "TLS": true, "TTL": true, "UDP": true, "UI": true,
+	"UID": true, "URI": true, "URL": true, "UTF8": true, "UUID": true, "XML": true,
+}
+
+func (lint Tagliatelle) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	rules := lintCtx.Settings().Tagliatelle.Rules
+	for key, c := range rules {
+		if tagliatelleCases[c] == nil {
+			return nil, fmt.Errorf("unknown case %q for tag key %q, only (%s) allowed",
+				c, key, strings.Join(tagliatelleCaseNames(), "|"))
+		}
+	}
+
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		ast.Inspect(f.F, func(node ast.Node) bool {
+			st, ok := node.(*ast.StructType)
+			if !ok {
+				return true
+			}
+
+			for _, field := range st.Fields.List {
+				if field.Tag != nil {
+					res = append(res, lint.checkTag(field.Tag, f.Fset, rules)...)
+				}
+			}
+			return true
+		})
+	}
+
+	return res, nil
+}
+
+func (lint Tagliatelle) checkTag(lit *ast.BasicLit, fset *token.FileSet, rules map[string]string) []result.Issue {
+	tag, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(rules))
+	for key := range rules {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys) // for deterministic order of issues
+
+	var res []result.Issue
+	for _, key := range keys {
+		value, ok := reflect.StructTag(tag).Lookup(key)
+		if !ok {
+			continue
+		}
+
+		name := strings.Split(value, ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		words := splitNameWords(name)
+		if len(words) == 0 {
+			continue
+		}
+
+		c := rules[key]
+		want := tagliatelleCases[c](words)
+		if want == name {
+			continue
+		}
+
+		i := result.Issue{
+			Pos:        fset.Position(lit.Pos()),
+			Text:       fmt.Sprintf("%s(%s): got '%s' want '%s'", key, c, name, want),
+			FromLinter: lint.Name(),
+		}
+		if newTag, ok := replaceTagName(tag, key, name, want); ok {
+			newValue := strconv.Quote(newTag)
+			if strings.HasPrefix(lit.Value, "`") && !strings.Contains(newTag, "`") {
+				newValue = "`" + newTag + "`"
+			}
+
+			i.SuggestedFixes = []result.SuggestedFix{{
+				Message: fmt.Sprintf("Rename %s to %s", name, want),
+				TextEdits: []result.TextEdit{{
+					Pos:     fset.Position(lit.Pos()).Offset,
+					End:     fset.Position(lit.End()).Offset,
+					NewText: newValue,
+				}},
+				Confidence: 0.5, // renaming changes the encoding format
+			}}
+		}
+		res = append(res, i)
+	}
+
+	return res
+}
+
+// replaceTagName replaces the name in the value of the key in the tag.
+func replaceTagName(tag, key, name, newName string) (string, bool) {
+	prefix := key + `:"` + name
+	for i := 0; i < len(tag); {
+		j := strings.Index(tag[i:], prefix)
+		if j == -1 {
+			return "", false
+		}
+		j += i
+
+		end := j + len(prefix)
+		if (j == 0 || tag[j-1] == ' ') && end < len(tag) && (tag[end] == '"' || tag[end] == ',') {
+			return tag[:j] + key + `:"` + newName + tag[end:], true
+		}
+		i = end
+	}
+
+	return "", false
+}
+
+// splitNameWords splits names like fooBar, FooBar, foo_bar, foo-bar and HTTPServer into words.
+func splitNameWords(name string) []string {
+	var words []string
+	var word []rune
+	flush := func() {
+		if len(word) != 0 {
+			words = append(words, string(word))
+			word = nil
+		}
+	}
+
+	runes := []rune(name)
+	for i, r := range runes {
+		if r == '_' || r == '-' || r == '.' || r == ' ' {
+			flush()
+			continue
+		}
+
+		if i != 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || unicode.IsUpper(prev) && nextIsLower {
+				flush()
+			}
+		}
+		word = append(word, r)
+	}
+	flush()
+
+	return words
+}
+
+func joinTitleWords(words []string, keepInitialisms bool) string {
+	var b strings.Builder
+	for _, w := range words {
+		upper := strings.ToUpper(w)
+		if keepInitialisms && tagliatelleInitialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+
+		lower := []rune(strings.ToLower(w))
+		lower[0] = unicode.ToUpper(lower[0])
+		b.WriteString(string(lower))
+	}
+
+	return b.String()
+}
+
+func tagliatelleCaseNames() []string {
+	var names []string
+	for name := range tagliatelleCases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
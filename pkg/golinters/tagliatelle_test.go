@@ -0,0 +1,43 @@
+package golinters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitNameWords(t *testing.T) {
+	for name, words := range map[string][]string{
+		"foo":        {"foo"},
+		"fooBar":     {"foo", "Bar"},
+		"FooBar":     {"Foo", "Bar"},
+		"foo_bar":    {"foo", "bar"},
+		"foo-bar":    {"foo", "bar"},
+		"HTTPServer": {"HTTP", "Server"},
+		"userID":     {"user", "ID"},
+		"utf8Name":   {"utf8", "Name"},
+	} {
+		assert.Equal(t, words, splitNameWords(name), name)
+	}
+}
+
+func TestTagliatelleCases(t *testing.T) {
+	words := []string{"user", "id", "url"}
+	for c, want := range map[string]string{
+		"camel":      "userIdUrl",
+		"pascal":     "UserIdUrl",
+		"goCamel":    "userIDURL",
+		"goPascal":   "UserIDURL",
+		"snake":      "user_id_url",
+		"upperSnake": "USER_ID_URL",
+		"kebab":      "user-id-url",
+	} {
+		assert.Equal(t, want, tagliatelleCases[c](words), c)
+	}
+}
+
+func TestReplaceTagName(t *testing.T) {
+	tag, ok := replaceTagName(`xjson:"a_b" json:"a_b,omitempty" yaml:"a_b"`, "json", "a_b", "aB")
+	assert.True(t, ok)
+	assert.Equal(t, `xjson:"a_b" json:"aB,omitempty" yaml:"a_b"`, tag)
+}
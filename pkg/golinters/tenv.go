@@ -0,0 +1,110 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Tenv struct{}
+
+func (Tenv) Name() string {
+	return "tenv"
+}
+
+func (Tenv) Desc() string {
+	return "Detects using os.Setenv instead of t.Setenv in tests"
+}
+
+func (lint Tenv) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		testingName := getImportName(f.F, "testing")
+		osName := getImportName(f.F, "os")
+		if testingName == "" || osName == "" {
+			continue
+		}
+
+		v := tenvVisitor{
+			fset:        f.Fset,
+			testingName: testingName,
+			osName:      osName,
+			cfg:         lintCtx.Cfg,
+			linterName:  lint.Name(),
+		}
+		for _, decl := range f.F.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+
+			if t := v.testingParam(fn.Type); t != "" {
+				v.checkBody(fn.Body, fn.Name.Name, t)
+			}
+		}
+		res = append(res, v.issues...)
+	}
+
+	return res, nil
+}
+
+type tenvVisitor struct {
+	fset        *token.FileSet
+	testingName string
+	osName      string
+	cfg         *config.Config
+	linterName  string
+
+	issues []result.Issue
+}
+
+// testingParam returns the name of the *testing.T or *testing.B parameter of the function.
+func (v *tenvVisitor) testingParam(ft *ast.FuncType) string {
+	for _, param := range ft.Params.List {
+		star, ok := param.Type.(*ast.StarExpr)
+		if !ok || !isPkgSelector(star.X, v.testingName, "T") && !isPkgSelector(star.X, v.testingName, "B") {
+			continue
+		}
+
+		for _, name := range param.Names {
+			if name.Name != "_" {
+				return name.Name
+			}
+		}
+	}
+
+	return ""
+}
+
+// checkBody reports os.Setenv and os.Unsetenv calls of the test fnName with the testing parameter t:
+// function literals with their own testing parameters, e.g. subtests, are checked with it.
+func (v *tenvVisitor) checkBody(body *ast.BlockStmt, fnName, t string) {
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.FuncLit:
+			if subT := v.testingParam(n.Type); subT != "" {
+				v.checkBody(n.Body, fnName, subT)
+				return false
+			}
+		case *ast.CallExpr:
+			for _, name := range []string{"Setenv", "Unsetenv"} {
+				if !isPkgSelector(n.Fun, v.osName, name) {
+					continue
+				}
+
+				v.issues = append(v.issues, result.Issue{
+					Pos: v.fset.Position(n.Pos()),
+					Text: fmt.Sprintf("os.%s() can be replaced by %s in %s", name,
+						formatCode(t+".Setenv()", v.cfg), fnName),
+					FromLinter: v.linterName,
+				})
+			}
+		}
+		return true
+	})
+}
@@ -0,0 +1,48 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Testpackage struct{}
+
+func (Testpackage) Name() string {
+	return "testpackage"
+}
+
+func (Testpackage) Desc() string {
+	return "Checks that tests are in a separate _test package"
+}
+
+func (lint Testpackage) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	skipRe, err := regexp.Compile(lintCtx.Settings().Testpackage.SkipRegexp)
+	if err != nil {
+		return nil, fmt.Errorf("can't compile skip-regexp: %s", err)
+	}
+
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		if !strings.HasSuffix(f.Name, "_test.go") || skipRe.MatchString(f.Name) {
+			continue
+		}
+
+		pkgName := f.F.Name.Name
+		if strings.HasSuffix(pkgName, "_test") {
+			continue
+		}
+
+		res = append(res, result.Issue{
+			Pos:        f.Fset.Position(f.F.Name.Pos()),
+			Text:       fmt.Sprintf("package should be `%s_test` instead of `%s`", pkgName, pkgName),
+			FromLinter: lint.Name(),
+		})
+	}
+
+	return res, nil
+}
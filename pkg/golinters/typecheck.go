@@ -2,6 +2,8 @@ package golinters
 
 import (
 	"context"
+	"go/scanner"
+	"regexp"
 
 	"golang.org/x/tools/go/packages"
 
@@ -26,13 +28,22 @@ func (lint TypeCheck) parseError(srcErr packages.Error) (*result.Issue, error) {
 		return nil, err
 	}
 
+	text := srcErr.Msg
+	if embedErrorRe.MatchString(text) {
+		// go list reports errors of //go:embed patterns at the directive without mentioning it
+		text = "invalid //go:embed directive: " + text
+	}
+
 	return &result.Issue{
 		Pos:        *pos,
-		Text:       srcErr.Msg,
+		Text:       text,
 		FromLinter: lint.Name(),
 	}, nil
 }
 
+// embedErrorRe matches errors of go list about embedded files, e.g. "pattern a.txt: no matching files found"
+var embedErrorRe = regexp.MustCompile(`^pattern \S+: (no matching files found|cannot embed|invalid pattern syntax|malformed file name)`)
+
 func (lint TypeCheck) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
 	uniqReportedIssues := map[string]bool{}
 
@@ -48,10 +59,33 @@ func (lint TypeCheck) Run(ctx context.Context, lintCtx *linter.Context) ([]resul
 				uniqReportedIssues[err.Msg] = true
 				lintCtx.Log.Errorf("typechecking error: %s", err.Msg)
 			} else {
+				uniqReportedIssues[i.Pos.String()] = true
 				res = append(res, *i)
 			}
 		}
 	}
 
+	// in best-effort mode files with syntax errors are analyzed by AST linters:
+	// their errors are reported here even if packages weren't typechecked
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		errList, ok := f.SyntaxErr.(scanner.ErrorList)
+		if !ok {
+			continue
+		}
+
+		for _, err := range errList {
+			if uniqReportedIssues[err.Pos.String()] {
+				continue
+			}
+			uniqReportedIssues[err.Pos.String()] = true
+
+			res = append(res, result.Issue{
+				Pos:        err.Pos,
+				Text:       err.Msg,
+				FromLinter: lint.Name(),
+			})
+		}
+	}
+
 	return res, nil
 }
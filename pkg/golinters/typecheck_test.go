@@ -47,3 +47,20 @@ func TestParseError(t *testing.T) {
 		assert.Equal(t, "msg", i.Text)
 	}
 }
+
+func TestParseEmbedError(t *testing.T) {
+	i, err := TypeCheck{}.parseError(packages.Error{
+		Pos: "f.go:5:12",
+		Msg: "pattern missing.txt: no matching files found",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, i.Line())
+	assert.Equal(t, "invalid //go:embed directive: pattern missing.txt: no matching files found", i.Text)
+
+	i, err = TypeCheck{}.parseError(packages.Error{
+		Pos: "f.go:5:2",
+		Msg: "undeclared name: pattern",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "undeclared name: pattern", i.Text)
+}
@@ -0,0 +1,285 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strconv"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Usestdlibvars struct{}
+
+func (Usestdlibvars) Name() string {
+	return "usestdlibvars"
+}
+
+func (Usestdlibvars) Desc() string {
+	return "Detects the possibility to use variables/constants from the Go standard library"
+}
+
+var httpMethodConsts = map[string]string{
+	"GET":     "MethodGet",
+	"HEAD":    "MethodHead",
+	"POST":    "MethodPost",
+	"PUT":     "MethodPut",
+	"PATCH":   "MethodPatch",
+	"DELETE":  "MethodDelete",
+	"CONNECT": "MethodConnect",
+	"OPTIONS": "MethodOptions",
+	"TRACE":   "MethodTrace",
+}
+
+var httpStatusCodeConsts = map[int]string{
+	100: "StatusContinue",
+	101: "StatusSwitchingProtocols",
+	102: "StatusProcessing",
+	103: "StatusEarlyHints",
+	200: "StatusOK",
+	201: "StatusCreated",
+	202: "StatusAccepted",
+	203: "StatusNonAuthoritativeInfo",
+	204: "StatusNoContent",
+	205: "StatusResetContent",
+	206: "StatusPartialContent",
+	207: "StatusMultiStatus",
+	208: "StatusAlreadyReported",
+	226: "StatusIMUsed",
+	300: "StatusMultipleChoices",
+	301: "StatusMovedPermanently",
+	302: "StatusFound",
+	303: "StatusSeeOther",
+	304: "StatusNotModified",
+	305: "StatusUseProxy",
+	307: "StatusTemporaryRedirect",
+	308: "StatusPermanentRedirect",
+	400: "StatusBadRequest",
+	401: "StatusUnauthorized",
+	402: "StatusPaymentRequired",
+	403: "StatusForbidden",
+	404: "StatusNotFound",
+	405: "StatusMethodNotAllowed",
+	406: "StatusNotAcceptable",
+	407: "StatusProxyAuthRequired",
+	408: "StatusRequestTimeout",
+	409: "StatusConflict",
+	410: "StatusGone",
+	411: "StatusLengthRequired",
+	412: "StatusPreconditionFailed",
+	413: "StatusRequestEntityTooLarge",
+	414: "StatusRequestURITooLong",
+	415: "StatusUnsupportedMediaType",
+	416: "StatusRequestedRangeNotSatisfiable",
+	417: "StatusExpectationFailed",
+	418: "StatusTeapot",
+	421: "StatusMisdirectedRequest",
+	422: "StatusUnprocessableEntity",
+	423: "StatusLocked",
+	424: "StatusFailedDependency",
+	425: "StatusTooEarly",
+	426: "StatusUpgradeRequired",
+	428: "StatusPreconditionRequired",
+	429: "StatusTooManyRequests",
+	431: "StatusRequestHeaderFieldsTooLarge",
+	451: "StatusUnavailableForLegalReasons",
+	500: "StatusInternalServerError",
+	501: "StatusNotImplemented",
+	502: "StatusBadGateway",
+	503: "StatusServiceUnavailable",
+	504: "StatusGatewayTimeout",
+	505: "StatusHTTPVersionNotSupported",
+	506: "StatusVariantAlsoNegotiates",
+	507: "StatusInsufficientStorage",
+	508: "StatusLoopDetected",
+	510: "StatusNotExtended",
+	511: "StatusNetworkAuthenticationRequired",
+}
+
+var timeWeekdayConsts = []string{
+	"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+}
+
+var timeMonthConsts = []string{
+	"January", "February", "March", "April", "May", "June",
+	"July", "August", "September", "October", "November", "December",
+}
+
+func (lint Usestdlibvars) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		v := usestdlibvarsVisitor{
+			settings: &lintCtx.Settings().Usestdlibvars,
+			fset:     f.Fset,
+			httpPkg:  getImportName(f.F, "net/http"),
+			testPkg:  getImportName(f.F, "net/http/httptest"),
+			timePkg:  getImportName(f.F, "time"),
+		}
+		ast.Walk(&v, f.F)
+		res = append(res, v.issues...)
+	}
+
+	return res, nil
+}
+
+type usestdlibvarsVisitor struct {
+	settings *config.UsestdlibvarsSettings
+	fset     *token.FileSet
+
+	// names which the file uses for the checked packages, "" if they aren't imported
+	httpPkg, testPkg, timePkg string
+
+	issues []result.Issue
+}
+
+func (v *usestdlibvarsVisitor) Visit(node ast.Node) ast.Visitor {
+	switch n := node.(type) {
+	case *ast.CallExpr:
+		v.checkCall(n)
+	case *ast.BinaryExpr:
+		if n.Op == token.EQL || n.Op == token.NEQ {
+			v.checkComparison(n.X, n.Y)
+			v.checkComparison(n.Y, n.X)
+		}
+	}
+
+	return v
+}
+
+func (v *usestdlibvarsVisitor) checkCall(call *ast.CallExpr) {
+	arg := func(i int) ast.Expr {
+		if i >= len(call.Args) {
+			return nil
+		}
+		return call.Args[i]
+	}
+
+	switch {
+	case isPkgSelector(call.Fun, v.httpPkg, "NewRequest"), isPkgSelector(call.Fun, v.testPkg, "NewRequest"):
+		v.checkHTTPMethod(arg(0))
+	case isPkgSelector(call.Fun, v.httpPkg, "NewRequestWithContext"):
+		v.checkHTTPMethod(arg(1))
+	case isPkgSelector(call.Fun, v.httpPkg, "StatusText"):
+		v.checkHTTPStatusCode(arg(0))
+	case isPkgSelector(call.Fun, v.httpPkg, "Error"):
+		v.checkHTTPStatusCode(arg(2))
+	case isPkgSelector(call.Fun, v.httpPkg, "Redirect"):
+		v.checkHTTPStatusCode(arg(3))
+	case isPkgSelector(call.Fun, v.timePkg, "Date"):
+		v.checkTimeMonth(arg(1))
+	case isMethodCall(call, "WriteHeader") && len(call.Args) == 1:
+		v.checkHTTPStatusCode(arg(0))
+	}
+}
+
+func (v *usestdlibvarsVisitor) checkComparison(x, y ast.Expr) {
+	if sel, ok := x.(*ast.SelectorExpr); ok {
+		switch sel.Sel.Name {
+		case "Method":
+			v.checkHTTPMethod(y)
+		case "StatusCode":
+			v.checkHTTPStatusCode(y)
+		}
+		return
+	}
+
+	if call, ok := x.(*ast.CallExpr); ok && len(call.Args) == 0 {
+		switch {
+		case isMethodCall(call, "Weekday"):
+			v.checkTimeWeekday(y)
+		case isMethodCall(call, "Month"):
+			v.checkTimeMonth(y)
+		}
+	}
+}
+
+func (v *usestdlibvarsVisitor) checkHTTPMethod(e ast.Expr) {
+	if !v.settings.HTTPMethod || v.httpPkg == "" {
+		return
+	}
+
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return
+	}
+
+	method, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return
+	}
+
+	if c, ok := httpMethodConsts[method]; ok {
+		v.report(lit, qualifyPkgName(v.httpPkg, c))
+	}
+}
+
+func (v *usestdlibvarsVisitor) checkHTTPStatusCode(e ast.Expr) {
+	if !v.settings.HTTPStatusCode || v.httpPkg == "" {
+		return
+	}
+
+	if c, ok := httpStatusCodeConsts[intLitValue(e)]; ok {
+		v.report(e.(*ast.BasicLit), qualifyPkgName(v.httpPkg, c))
+	}
+}
+
+func (v *usestdlibvarsVisitor) checkTimeWeekday(e ast.Expr) {
+	if !v.settings.TimeWeekday || v.timePkg == "" {
+		return
+	}
+
+	if wd := intLitValue(e); wd >= 0 && wd < len(timeWeekdayConsts) {
+		v.report(e.(*ast.BasicLit), qualifyPkgName(v.timePkg, timeWeekdayConsts[wd]))
+	}
+}
+
+func (v *usestdlibvarsVisitor) checkTimeMonth(e ast.Expr) {
+	if !v.settings.TimeMonth || v.timePkg == "" {
+		return
+	}
+
+	if m := intLitValue(e); m >= 1 && m <= len(timeMonthConsts) {
+		v.report(e.(*ast.BasicLit), qualifyPkgName(v.timePkg, timeMonthConsts[m-1]))
+	}
+}
+
+func (v *usestdlibvarsVisitor) report(lit *ast.BasicLit, replacement string) {
+	v.issues = append(v.issues, result.Issue{
+		Pos: v.fset.Position(lit.Pos()),
+		Text: fmt.Sprintf("%s can be replaced by %s",
+			formatCode(lit.Value, nil), formatCode(replacement, nil)),
+		FromLinter: Usestdlibvars{}.Name(),
+		SuggestedFixes: []result.SuggestedFix{{
+			Message: fmt.Sprintf("Replace with %s", replacement),
+			TextEdits: []result.TextEdit{{
+				Pos:     v.fset.Position(lit.Pos()).Offset,
+				End:     v.fset.Position(lit.End()).Offset,
+				NewText: replacement,
+			}},
+			Confidence: 0.9,
+		}},
+	})
+}
+
+// intLitValue returns the value of the decimal integer literal e or -1 if e isn't such a literal.
+func intLitValue(e ast.Expr) int {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return -1
+	}
+
+	v, err := strconv.Atoi(lit.Value)
+	if err != nil {
+		return -1
+	}
+
+	return v
+}
+
+func isMethodCall(call *ast.CallExpr, name string) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	return ok && sel.Sel.Name == name
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"go/ast"
 	"go/token"
+	pathpkg "path"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -113,7 +115,7 @@ func getASTFilesForGoPkg(ctx *linter.Context, pkg *gopackages.Package) ([]*ast.F
 	for _, filename := range pkg.GoFiles {
 		f := ctx.ASTCache.Get(filename)
 		if f == nil {
-			return nil, nil, fmt.Errorf("no AST for file %s in cache: %+v", filename, *ctx.ASTCache)
+			return nil, nil, fmt.Errorf("no AST for file %s in cache: %+v", filename, ctx.ASTCache.ParsedFilenames())
 		}
 
 		if f.Err != nil {
@@ -126,3 +128,55 @@ func getASTFilesForGoPkg(ctx *linter.Context, pkg *gopackages.Package) ([]*ast.F
 
 	return files, fset, nil
 }
+
+// getImportName returns the name by which file f refers to the package with the given
+// import path: "" if the package isn't imported (or is imported only for side effects)
+// and "." for dot imports.
+func getImportName(f *ast.File, path string) string {
+	for _, imp := range f.Imports {
+		impPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil || impPath != path {
+			continue
+		}
+
+		if imp.Name == nil {
+			return pathpkg.Base(path)
+		}
+		if imp.Name.Name == "_" {
+			return ""
+		}
+		return imp.Name.Name
+	}
+
+	return ""
+}
+
+// isPkgSelector reports whether expr is a reference to name from the package
+// imported by a file as pkgName (see getImportName).
+func isPkgSelector(expr ast.Expr, pkgName, name string) bool {
+	if pkgName == "" {
+		return false
+	}
+
+	if pkgName == "." {
+		ident, ok := expr.(*ast.Ident)
+		return ok && ident.Name == name
+	}
+
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != name {
+		return false
+	}
+
+	x, ok := sel.X.(*ast.Ident)
+	return ok && x.Name == pkgName
+}
+
+// qualifyPkgName returns the code referring to name from the package imported as pkgName.
+func qualifyPkgName(pkgName, name string) string {
+	if pkgName == "." {
+		return name
+	}
+
+	return pkgName + "." + name
+}
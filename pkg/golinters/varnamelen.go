@@ -0,0 +1,112 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Varnamelen struct{}
+
+func (Varnamelen) Name() string {
+	return "varnamelen"
+}
+
+func (Varnamelen) Desc() string {
+	return "Checks that the length of a variable's name matches its usage scope"
+}
+
+// varnamelenIdioms are conventional short names allowed in any scope
+var varnamelenIdioms = []string{"i", "j", "k", "err", "ok"}
+
+func (lint Varnamelen) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	settings := lintCtx.Settings().Varnamelen
+	ignored := map[string]bool{"_": true}
+	for _, names := range [][]string{varnamelenIdioms, settings.IgnoreNames} {
+		for _, name := range names {
+			ignored[name] = true
+		}
+	}
+
+	var res []result.Issue
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		for _, decl := range f.F.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+
+			for _, v := range varnamelenVars(fn.Body, f.Fset) {
+				name := v.decl.Name
+				if len(name) >= settings.MinNameLength || ignored[name] || v.lastUseLine-v.declLine <= settings.MaxDistance {
+					continue
+				}
+
+				res = append(res, result.Issue{
+					Pos:        f.Fset.Position(v.decl.Pos()),
+					Text:       fmt.Sprintf("variable name %s is too short for the scope of its usage", formatCode(name, lintCtx.Cfg)),
+					FromLinter: lint.Name(),
+				})
+			}
+		}
+	}
+
+	return res, nil
+}
+
+type varnamelenVar struct {
+	decl        *ast.Ident
+	declLine    int
+	lastUseLine int
+}
+
+// varnamelenVars returns local variables declared in the function body in the order of declarations.
+// Parameters and receivers aren't checked: their names are conventional, e.g. t for *testing.T.
+func varnamelenVars(body *ast.BlockStmt, fset *token.FileSet) []*varnamelenVar {
+	var vars []*varnamelenVar
+	byObj := map[*ast.Object]*varnamelenVar{}
+	declare := func(e ast.Expr) {
+		id, ok := e.(*ast.Ident)
+		if !ok || id.Obj == nil || id.Obj.Kind != ast.Var || id.Obj.Pos() != id.Pos() || byObj[id.Obj] != nil {
+			return // not a new variable, e.g. a redeclared one in :=
+		}
+
+		line := fset.Position(id.Pos()).Line
+		v := &varnamelenVar{decl: id, declLine: line, lastUseLine: line}
+		byObj[id.Obj] = v
+		vars = append(vars, v)
+	}
+
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE {
+				for _, e := range n.Lhs {
+					declare(e)
+				}
+			}
+		case *ast.RangeStmt:
+			if n.Tok == token.DEFINE {
+				declare(n.Key)
+				declare(n.Value)
+			}
+		case *ast.ValueSpec:
+			for _, id := range n.Names {
+				declare(id)
+			}
+		case *ast.Ident:
+			if v := byObj[n.Obj]; v != nil {
+				if line := fset.Position(n.Pos()).Line; line > v.lastUseLine {
+					v.lastUseLine = line
+				}
+			}
+		}
+		return true
+	})
+
+	return vars
+}
@@ -0,0 +1,176 @@
+package golinters
+
+import (
+	"context"
+	"go/token"
+
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/go/ssa"
+	"golang.org/x/tools/go/ssa/ssautil"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Wastedassign struct{}
+
+func (Wastedassign) Name() string {
+	return "wastedassign"
+}
+
+func (Wastedassign) Desc() string {
+	return "Finds wasted assignment statements"
+}
+
+func (lint Wastedassign) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	var pkgs []*packages.Package
+	for _, pkg := range lintCtx.Packages {
+		if !pkg.IllTyped {
+			pkgs = append(pkgs, pkg)
+		}
+	}
+	if len(pkgs) == 0 {
+		return nil, nil
+	}
+
+	// lintCtx.SSAProgram has lifted form: local variables are registers there,
+	// but we need their loads and stores
+	prog, ssaPkgs := ssautil.Packages(pkgs, ssa.NaiveForm)
+	lintedPkgs := map[*ssa.Package]bool{}
+	for _, ssaPkg := range ssaPkgs {
+		if ssaPkg != nil {
+			ssaPkg.Build()
+			lintedPkgs[ssaPkg] = true
+		}
+	}
+
+	var res []result.Issue
+	for fn := range ssautil.AllFunctions(prog) {
+		if !lintedPkgs[fn.Pkg] || fn.Synthetic != "" || len(fn.Blocks) == 0 {
+			continue
+		}
+
+		res = append(res, lint.checkFunc(prog.Fset, fn)...)
+	}
+
+	return res, nil
+}
+
+func (lint Wastedassign) checkFunc(fset *token.FileSet, fn *ssa.Function) []result.Issue {
+	var res []result.Issue
+	for _, local := range fn.Locals {
+		stores, ok := getLocalStores(local)
+		if !ok {
+			continue
+		}
+
+		for _, store := range stores {
+			if store.Pos() == token.NoPos {
+				continue // implicit store, e.g. of a parameter value
+			}
+			if _, ok := store.Val.(*ssa.Parameter); ok {
+				continue
+			}
+
+			var text string
+			switch getStoreUsage(store) {
+			case storeOverwritten:
+				text = "wasted assignment"
+			case storeNotUsed:
+				text = "reassigned, but never used afterwards"
+			default:
+				continue
+			}
+
+			res = append(res, result.Issue{
+				Pos:        fset.Position(store.Pos()),
+				Text:       text,
+				FromLinter: lint.Name(),
+			})
+		}
+	}
+
+	return res
+}
+
+// getLocalStores returns stores to the local variable if it's used only by stores and loads:
+// we can't track usages of the variable if its address is taken.
+func getLocalStores(local *ssa.Alloc) ([]*ssa.Store, bool) {
+	if local.Heap {
+		return nil, false // it's captured by closure or escapes
+	}
+
+	var stores []*ssa.Store
+	for _, ref := range *local.Referrers() {
+		switch ref := ref.(type) {
+		case *ssa.Store:
+			if ref.Addr != local {
+				return nil, false // address is stored
+			}
+			stores = append(stores, ref)
+		case *ssa.UnOp:
+			if ref.Op != token.MUL {
+				return nil, false
+			}
+		case *ssa.DebugRef:
+		default:
+			return nil, false
+		}
+	}
+
+	return stores, true
+}
+
+type storeUsage int
+
+const (
+	storeUsed        storeUsage = iota // value is loaded on some path
+	storeOverwritten                   // value is overwritten on all paths, at least on one before the return
+	storeNotUsed                       // function returns on all paths without using of the value
+)
+
+func getStoreUsage(store *ssa.Store) storeUsage {
+	block := store.Block()
+	var after []ssa.Instruction
+	for i, instr := range block.Instrs {
+		if instr == store {
+			after = block.Instrs[i+1:]
+			break
+		}
+	}
+
+	usage := storeNotUsed
+	visited := map[*ssa.BasicBlock]bool{}
+	var walk func(instrs []ssa.Instruction, block *ssa.BasicBlock) bool
+	walk = func(instrs []ssa.Instruction, block *ssa.BasicBlock) bool {
+		for _, instr := range instrs {
+			switch instr := instr.(type) {
+			case *ssa.UnOp:
+				if instr.Op == token.MUL && instr.X == store.Addr {
+					return true
+				}
+			case *ssa.Store:
+				if instr.Addr == store.Addr {
+					usage = storeOverwritten
+					return false
+				}
+			}
+		}
+
+		for _, succ := range block.Succs {
+			if visited[succ] {
+				continue
+			}
+			visited[succ] = true
+			if walk(succ.Instrs, succ) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if walk(after, block) {
+		return storeUsed
+	}
+	return usage
+}
@@ -0,0 +1,205 @@
+package golinters
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type Wrapcheck struct{}
+
+func (Wrapcheck) Name() string {
+	return "wrapcheck"
+}
+
+func (Wrapcheck) Desc() string {
+	return "Checks that errors returned from external packages are wrapped"
+}
+
+func (lint Wrapcheck) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	return checkTypedPackages(ctx, lintCtx, lint.Name(), func(ctx context.Context, pkg *packages.Package) []result.Issue {
+		v := wrapcheckVisitor{settings: &lintCtx.Settings().Wrapcheck, pkg: pkg}
+		for _, f := range pkg.Syntax {
+			ast.Inspect(f, func(node ast.Node) bool {
+				if ctx.Err() != nil {
+					return false // the package timeout is exceeded
+				}
+
+				switch n := node.(type) {
+				case *ast.FuncDecl:
+					if n.Body != nil {
+						v.checkBody(n.Body)
+					}
+				case *ast.FuncLit:
+					v.checkBody(n.Body)
+				}
+				return true
+			})
+		}
+		return v.issues
+	}), nil
+}
+
+type wrapcheckVisitor struct {
+	settings *config.WrapcheckSettings
+	pkg      *packages.Package
+
+	issues []result.Issue
+}
+
+// errorAssignment is an assignment of an error returned by the call to the variable
+type errorAssignment struct {
+	obj  types.Object
+	call *ast.CallExpr
+	pos  token.Pos
+}
+
+// checkBody checks returns of the function body, nested functions are checked separately.
+func (v *wrapcheckVisitor) checkBody(body *ast.BlockStmt) {
+	var assignments []errorAssignment
+	var returns []*ast.ReturnStmt
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.AssignStmt:
+			assignments = append(assignments, v.errorAssignments(n.Lhs, n.Rhs, n.Pos())...)
+		case *ast.ValueSpec:
+			var lhs []ast.Expr
+			for _, name := range n.Names {
+				lhs = append(lhs, name)
+			}
+			assignments = append(assignments, v.errorAssignments(lhs, n.Values, n.Pos())...)
+		case *ast.ReturnStmt:
+			returns = append(returns, n)
+		}
+		return true
+	})
+
+	for _, ret := range returns {
+		for _, e := range ret.Results {
+			if !v.isError(e) {
+				continue
+			}
+
+			call, ok := e.(*ast.CallExpr)
+			if !ok {
+				call = lastAssignedCall(assignments, e, ret.Pos(), v.pkg.TypesInfo)
+			}
+			if call != nil {
+				v.checkCall(e, call)
+			}
+		}
+	}
+}
+
+func (v *wrapcheckVisitor) errorAssignments(lhs, rhs []ast.Expr, pos token.Pos) []errorAssignment {
+	var res []errorAssignment
+	for i, l := range lhs {
+		id, ok := l.(*ast.Ident)
+		if !ok || !v.isError(l) {
+			continue
+		}
+
+		var call *ast.CallExpr
+		switch {
+		case len(rhs) == len(lhs):
+			call, _ = rhs[i].(*ast.CallExpr)
+		case len(rhs) == 1:
+			call, _ = rhs[0].(*ast.CallExpr) // multi-value call
+		}
+
+		obj := v.pkg.TypesInfo.ObjectOf(id)
+		if obj != nil {
+			// assignments not from calls reset the variable too
+			res = append(res, errorAssignment{obj: obj, call: call, pos: pos})
+		}
+	}
+
+	return res
+}
+
+// lastAssignedCall returns the call assigned to the variable last before the return.
+func lastAssignedCall(assignments []errorAssignment, e ast.Expr, returnPos token.Pos, info *types.Info) *ast.CallExpr {
+	id, ok := e.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+
+	obj := info.ObjectOf(id)
+	var call *ast.CallExpr
+	for _, a := range assignments {
+		if a.obj == obj && a.pos < returnPos {
+			call = a.call
+		}
+	}
+
+	return call
+}
+
+func (v *wrapcheckVisitor) checkCall(returned ast.Expr, call *ast.CallExpr) {
+	var fn *types.Func
+	isInterfaceMethod := false
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		fn, _ = v.pkg.TypesInfo.Uses[fun].(*types.Func)
+	case *ast.SelectorExpr:
+		fn, _ = v.pkg.TypesInfo.Uses[fun.Sel].(*types.Func)
+		if sel, ok := v.pkg.TypesInfo.Selections[fun]; ok {
+			_, isInterfaceMethod = sel.Recv().Underlying().(*types.Interface)
+		}
+	}
+	if fn == nil || fn.Pkg() == nil {
+		return
+	}
+
+	if !isInterfaceMethod && fn.Pkg() == v.pkg.Types {
+		return // errors of the package are wrapped inside it
+	}
+	if v.isIgnored(fn) {
+		return
+	}
+
+	text := "error returned from external package is unwrapped: sig: %s"
+	if isInterfaceMethod {
+		text = "error returned from interface method should be wrapped: sig: %s"
+	}
+
+	v.issues = append(v.issues, result.Issue{
+		Pos:        v.pkg.Fset.Position(returned.Pos()),
+		Text:       fmt.Sprintf(text, fn.String()),
+		FromLinter: Wrapcheck{}.Name(),
+	})
+}
+
+func (v *wrapcheckVisitor) isIgnored(fn *types.Func) bool {
+	name := fn.FullName() + "("
+	for _, sig := range v.settings.IgnoreSigs {
+		if strings.Contains(name, sig) {
+			return true
+		}
+	}
+
+	for _, glob := range v.settings.IgnorePackageGlobs {
+		if matched, _ := path.Match(glob, fn.Pkg().Path()); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v *wrapcheckVisitor) isError(e ast.Expr) bool {
+	t := v.pkg.TypesInfo.TypeOf(e)
+	return t != nil && types.Identical(t, errorType)
+}
@@ -0,0 +1,72 @@
+package goutil
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+var versionRe = regexp.MustCompile(`^(?:go)?1\.(\d+)`)
+
+// ParseMinorVersion returns the minor part of a Go version like 1.12, 1.12.3 or go1.12rc1.
+func ParseMinorVersion(v string) (int, error) {
+	m := versionRe.FindStringSubmatch(strings.TrimSpace(v))
+	if m == nil {
+		return 0, fmt.Errorf("invalid go version %q, expected format is 1.N", v)
+	}
+
+	return strconv.Atoi(m[1])
+}
+
+// Version returns the Go version linters should target in the form 1.N.
+// The version configured by run.go is authoritative: it makes results reproducible
+// with different toolchains. The go directive of go.mod at goModPath is used next
+// and the version of the running toolchain is the last resort.
+func Version(cfgVersion, goModPath string) (string, error) {
+	if cfgVersion != "" {
+		minor, err := ParseMinorVersion(cfgVersion)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("1.%d", minor), nil
+	}
+
+	return resolveVersion(goModVersion(goModPath), runtime.Version()), nil
+}
+
+func resolveVersion(candidates ...string) string {
+	for _, v := range candidates {
+		if minor, err := ParseMinorVersion(v); err == nil {
+			return fmt.Sprintf("1.%d", minor)
+		}
+	}
+
+	return ""
+}
+
+// goModVersion returns the version from the go directive of go.mod or "" if there is no such directive.
+func goModVersion(goModPath string) string {
+	if goModPath == "" || goModPath == os.DevNull {
+		return ""
+	}
+
+	f, err := os.Open(goModPath)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "go" {
+			return fields[1]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,54 @@
+package goutil
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeGoMod(t *testing.T, goVersion string) string {
+	dir, err := ioutil.TempDir("", "golangci_version_test")
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, "go.mod")
+	content := fmt.Sprintf("module example.com/m\n\ngo %s\n", goVersion)
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), os.ModePerm))
+	return path
+}
+
+func TestParseMinorVersion(t *testing.T) {
+	for v, minor := range map[string]int{"1.9": 9, "1.12.3": 12, "go1.11": 11, "go1.13rc1": 13} {
+		got, err := ParseMinorVersion(v)
+		assert.NoError(t, err, v)
+		assert.Equal(t, minor, got, v)
+	}
+
+	_, err := ParseMinorVersion("2.0")
+	assert.Error(t, err)
+}
+
+func TestVersionConfigOverridesGoModAndRuntime(t *testing.T) {
+	goModPath := writeGoMod(t, "1.11")
+	defer os.RemoveAll(filepath.Dir(goModPath))
+
+	v, err := Version("1.9", goModPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.9", v)
+
+	v, err = Version("", goModPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.11", v)
+
+	v, err = Version("", os.DevNull)
+	assert.NoError(t, err)
+	assert.Equal(t, resolveVersion(runtime.Version()), v)
+
+	_, err = Version("latest", goModPath)
+	assert.Error(t, err)
+}
@@ -3,8 +3,11 @@ package astcache
 import (
 	"go/ast"
 	"go/parser"
+	"go/scanner"
 	"go/token"
+	"io/ioutil"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"golang.org/x/tools/go/packages"
@@ -17,22 +20,31 @@ type File struct {
 	Fset *token.FileSet
 	Name string
 	Err  error
+
+	// SyntaxErr is set in best-effort mode if F is a partial AST of the file with syntax errors
+	SyntaxErr error
 }
 
 type Cache struct {
 	m   map[string]*File // map from absolute file path to file data
 	s   []*File
 	log logutils.Log
+
+	mu       sync.Mutex // protects m while files are parsed concurrently
+	readFile func(filename string) ([]byte, error)
+
+	bestEffort bool // keep partial ASTs of files with syntax errors
 }
 
 func NewCache(log logutils.Log) *Cache {
 	return &Cache{
-		m:   map[string]*File{},
-		log: log,
+		m:        map[string]*File{},
+		log:      log,
+		readFile: ioutil.ReadFile,
 	}
 }
 
-func (c Cache) ParsedFilenames() []string {
+func (c *Cache) ParsedFilenames() []string {
 	var keys []string
 	for k := range c.m {
 		keys = append(keys, k)
@@ -40,7 +52,7 @@ func (c Cache) ParsedFilenames() []string {
 	return keys
 }
 
-func (c Cache) normalizeFilename(filename string) string {
+func (c *Cache) normalizeFilename(filename string) string {
 	if filepath.IsAbs(filename) {
 		return filepath.Clean(filename)
 	}
@@ -54,11 +66,11 @@ func (c Cache) normalizeFilename(filename string) string {
 	return absFilename
 }
 
-func (c Cache) Get(filename string) *File {
+func (c *Cache) Get(filename string) *File {
 	return c.m[c.normalizeFilename(filename)]
 }
 
-func (c Cache) GetAllValidFiles() []*File {
+func (c *Cache) GetAllValidFiles() []*File {
 	return c.s
 }
 
@@ -85,31 +97,101 @@ func LoadFromFilenames(log logutils.Log, filenames ...string) *Cache {
 	return c
 }
 
-func LoadFromPackages(pkgs []*packages.Package, log logutils.Log) (*Cache, error) {
+// LoadFromPackages builds the cache from the loaded packages. Files that have to be
+// parsed are parsed one by one or, if maxOpenFiles > 1, concurrently, but no more than
+// maxOpenFiles of them are open at once.
+// In best-effort mode files with syntax errors are still returned by GetAllValidFiles
+// with the partial AST which parser was able to build. Files from overlay (absolute path to contents)
+// are parsed from these contents instead of disk.
+func LoadFromPackages(pkgs []*packages.Package, maxOpenFiles int, bestEffort bool, overlay map[string][]byte,
+	log logutils.Log) (*Cache, error) {
+
 	c := NewCache(log)
+	c.bestEffort = bestEffort
+	if len(overlay) != 0 {
+		c.readFile = overlayReadFile(overlay, c.readFile)
+	}
+	c.loadFromPackages(pkgs, maxOpenFiles)
+	c.prepareValidFiles()
+	return c, nil
+}
+
+func overlayReadFile(overlay map[string][]byte, readFile func(string) ([]byte, error)) func(string) ([]byte, error) {
+	contents := make(map[string][]byte, len(overlay))
+	for filename, src := range overlay {
+		contents[filepath.Clean(filename)] = src
+	}
 
+	return func(filename string) ([]byte, error) {
+		if src, ok := contents[filename]; ok {
+			return src, nil
+		}
+
+		return readFile(filename)
+	}
+}
+
+type parseTask struct {
+	filename string
+	fset     *token.FileSet
+}
+
+func (c *Cache) loadFromPackages(pkgs []*packages.Package, maxOpenFiles int) {
+	var tasks []parseTask
 	for _, pkg := range pkgs {
-		c.loadFromPackage(pkg)
+		tasks = append(tasks, c.loadFromPackage(pkg)...)
 	}
 
-	c.prepareValidFiles()
-	return c, nil
+	if len(tasks) == 0 {
+		return
+	}
+
+	startedAt := time.Now()
+	c.parseFiles(tasks, maxOpenFiles)
+	c.log.Infof("Parsed AST of %d files with max %d open files for %s", len(tasks), maxOpenFiles, time.Since(startedAt))
+}
+
+func (c *Cache) parseFiles(tasks []parseTask, maxOpenFiles int) {
+	if maxOpenFiles <= 1 {
+		for _, t := range tasks {
+			c.parseFile(t.filename, t.fset)
+		}
+		return
+	}
+
+	sem := make(chan struct{}, maxOpenFiles)
+	var wg sync.WaitGroup
+	for _, t := range tasks {
+		t := t
+		wg.Add(1)
+		sem <- struct{}{} // acquire before starting goroutine to not start them for all files at once
+		go func() {
+			defer wg.Done()
+			c.parseFile(t.filename, t.fset)
+			<-sem
+		}()
+	}
+	wg.Wait()
 }
 
-func (c *Cache) loadFromPackage(pkg *packages.Package) {
+// loadFromPackage saves already parsed package files into the cache
+// and returns files that must be parsed.
+func (c *Cache) loadFromPackage(pkg *packages.Package) []parseTask {
 	if len(pkg.Syntax) == 0 || len(pkg.GoFiles) != len(pkg.CompiledGoFiles) {
 		// len(pkg.Syntax) == 0 if only filenames are loaded
 		// lengths aren't equal if there are preprocessed files (cgo)
-		startedAt := time.Now()
 
 		// can't use pkg.Fset: it will overwrite offsets by preprocessed files
 		fset := token.NewFileSet()
+		tasks := make([]parseTask, 0, len(pkg.GoFiles))
 		for _, f := range pkg.GoFiles {
-			c.parseFile(f, fset)
+			tasks = append(tasks, parseTask{
+				filename: f,
+				fset:     fset,
+			})
 		}
 
-		c.log.Infof("Parsed AST of all pkg.GoFiles: %s for %s", pkg.GoFiles, time.Since(startedAt))
-		return
+		return tasks
 	}
 
 	for _, f := range pkg.Syntax {
@@ -124,6 +206,8 @@ func (c *Cache) loadFromPackage(pkg *packages.Package) {
 			Name: pos.Filename,
 		}
 	}
+
+	return nil
 }
 
 func (c *Cache) parseFile(filePath string, fset *token.FileSet) {
@@ -133,15 +217,35 @@ func (c *Cache) parseFile(filePath string, fset *token.FileSet) {
 
 	filePath = c.normalizeFilename(filePath)
 
-	// comments needed by e.g. golint
-	f, err := parser.ParseFile(fset, filePath, nil, parser.ParseComments)
+	mode := parser.ParseComments // comments needed by e.g. golint
+	if c.bestEffort {
+		mode |= parser.AllErrors
+	}
+
+	var f *ast.File
+	src, err := c.readFile(filePath)
+	if err == nil {
+		f, err = parser.ParseFile(fset, filePath, src, mode)
+	}
+
+	var syntaxErr error
+	if _, ok := err.(scanner.ErrorList); ok && c.bestEffort && f != nil {
+		syntaxErr, err = err, nil
+	}
+
+	c.mu.Lock()
 	c.m[filePath] = &File{
-		F:    f,
-		Fset: fset,
-		Err:  err,
-		Name: filePath,
+		F:         f,
+		Fset:      fset,
+		Err:       err,
+		Name:      filePath,
+		SyntaxErr: syntaxErr,
 	}
+	c.mu.Unlock()
+
 	if err != nil {
 		c.log.Warnf("Can't parse AST of %s: %s", filePath, err)
+	} else if syntaxErr != nil {
+		c.log.Infof("Parsed partial AST of %s: %s", filePath, syntaxErr)
 	}
 }
@@ -0,0 +1,68 @@
+package astcache
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+type countingFileReader struct {
+	mu          sync.Mutex
+	opened      int
+	maxOpened   int
+	openedFiles int
+}
+
+func (r *countingFileReader) readFile(filename string) ([]byte, error) {
+	r.mu.Lock()
+	r.opened++
+	r.openedFiles++
+	if r.opened > r.maxOpened {
+		r.maxOpened = r.opened
+	}
+	r.mu.Unlock()
+
+	time.Sleep(time.Millisecond) // give other goroutines a chance to open files
+
+	r.mu.Lock()
+	r.opened--
+	r.mu.Unlock()
+
+	return []byte("package p\n"), nil
+}
+
+func TestLoadFromPackagesMaxOpenFiles(t *testing.T) {
+	var pkgs []*packages.Package
+	for i := 0; i < 10; i++ {
+		var files []string
+		for j := 0; j < 10; j++ {
+			files = append(files, fmt.Sprintf("/p%d/f%d.go", i, j))
+		}
+		pkgs = append(pkgs, &packages.Package{
+			GoFiles:         files,
+			CompiledGoFiles: files,
+		})
+	}
+
+	for _, maxOpenFiles := range []int{0, 1, 3, 16} {
+		r := &countingFileReader{}
+		c := NewCache(logutils.NewStderrLog("test"))
+		c.readFile = r.readFile
+		c.loadFromPackages(pkgs, maxOpenFiles)
+		c.prepareValidFiles()
+
+		assert.Equal(t, 100, r.openedFiles)
+		limit := maxOpenFiles
+		if limit == 0 {
+			limit = 1 // files are opened one by one by default
+		}
+		assert.True(t, r.maxOpened <= limit, "opened %d files at once, limit is %d", r.maxOpened, limit)
+		assert.Len(t, c.GetAllValidFiles(), 100)
+	}
+}
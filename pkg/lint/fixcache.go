@@ -0,0 +1,127 @@
+package lint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// fixCache stores issues of linters with their suggested fixes by a key of the linters inputs:
+// a subsequent run with --fix applies cached fixes without running linters if no input was changed.
+// The key includes contents of analyzed files, so cached edits are never applied to changed files.
+type fixCache struct {
+	dir string
+	key string
+	log logutils.Log
+}
+
+func defaultFixCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "golangci-lint", "fixes"), nil
+}
+
+func newFixCache(dir string, lintCtx *linter.Context, linters []*linter.Config, log logutils.Log) (*fixCache, error) {
+	key, err := fixCacheKey(lintCtx, linters)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fixCache{
+		dir: dir,
+		key: key,
+		log: log,
+	}, nil
+}
+
+func fixCacheKey(lintCtx *linter.Context, linters []*linter.Config) (string, error) {
+	h := sha256.New()
+
+	var names []string
+	for _, lc := range linters {
+		names = append(names, lc.Name())
+	}
+	sort.Strings(names)
+
+	cfg := lintCtx.Cfg
+	settings, err := json.Marshal(struct {
+		Linters      []string
+		Settings     interface{}
+		BuildTags    []string
+		AnalyzeTests bool
+		GoVersion    string
+	}{names, cfg.LintersSettings, cfg.Run.BuildTags, cfg.Run.AnalyzeTests, lintCtx.GoVersion})
+	if err != nil {
+		return "", err
+	}
+	h.Write(settings)
+
+	files := lintCtx.ASTCache.ParsedFilenames()
+	sort.Strings(files)
+	for _, f := range files {
+		content, ok := cfg.Run.Overlay[f]
+		if !ok {
+			if content, err = ioutil.ReadFile(f); err != nil {
+				return "", err
+			}
+		}
+
+		contentHash := sha256.Sum256(content)
+		fmt.Fprintf(h, "%s\x00%x\x00", f, contentHash)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func (c fixCache) path() string {
+	return filepath.Join(c.dir, c.key+".json")
+}
+
+// get returns cached issues of every linter by linter name.
+func (c fixCache) get() (map[string][]result.Issue, bool) {
+	data, err := ioutil.ReadFile(c.path())
+	if err != nil {
+		if !os.IsNotExist(err) {
+			c.log.Warnf("Can't read fix cache: %s", err)
+		}
+		return nil, false
+	}
+
+	var issues map[string][]result.Issue
+	if err := json.Unmarshal(data, &issues); err != nil {
+		c.log.Warnf("Can't decode fix cache %s: %s", c.path(), err)
+		return nil, false
+	}
+
+	return issues, true
+}
+
+func (c fixCache) put(issues map[string][]result.Issue) error {
+	data, err := json.Marshal(issues)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.dir, os.ModePerm); err != nil {
+		return err
+	}
+
+	tmpPath := c.path() + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, c.path())
+}
@@ -0,0 +1,64 @@
+package lint
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/golinters"
+	"github.com/golangci/golangci-lint/pkg/lint/astcache"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestFixCache(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "fixcache")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	filename := filepath.Join(tmpDir, "a.go")
+	require.NoError(t, ioutil.WriteFile(filename, []byte("package a\n"), os.ModePerm))
+
+	log := logutils.NewStderrLog("")
+	linters := []*linter.Config{linter.NewConfig(golinters.Gofmt{})}
+	cacheDir := filepath.Join(tmpDir, "cache")
+	newCache := func() *fixCache {
+		lintCtx := &linter.Context{
+			Cfg:      config.NewDefault(),
+			ASTCache: astcache.LoadFromFilenames(log, filename),
+		}
+		c, err := newFixCache(cacheDir, lintCtx, linters, log)
+		require.NoError(t, err)
+		return c
+	}
+
+	_, ok := newCache().get()
+	assert.False(t, ok)
+
+	issues := map[string][]result.Issue{
+		"gofmt": {{
+			FromLinter: "gofmt",
+			Text:       "File is not `gofmt`-ed",
+			SuggestedFixes: []result.SuggestedFix{{
+				Message:    "Format the file",
+				TextEdits:  []result.TextEdit{{Pos: 0, End: 7, NewText: "package"}},
+				Confidence: 1,
+			}},
+		}},
+	}
+	require.NoError(t, newCache().put(issues))
+
+	cached, ok := newCache().get()
+	require.True(t, ok)
+	assert.Equal(t, issues, cached)
+
+	require.NoError(t, ioutil.WriteFile(filename, []byte("package b\n"), os.ModePerm))
+	_, ok = newCache().get()
+	assert.False(t, ok, "cached fixes must not be used for changed files")
+}
@@ -22,6 +22,10 @@ type Config struct {
 
 	OriginalURL      string // URL of original (not forked) repo, needed for autogenerated README
 	ParentLinterName string // used only for megacheck's children now
+
+	// ObsoleteSinceGo is a Go version in the form 1.N since which the linter is obsolete
+	// because the language fixed what it detects, "" if it isn't obsolete
+	ObsoleteSinceGo string
 }
 
 func (lc *Config) WithTypeInfo() *Config {
@@ -60,10 +64,36 @@ func (lc *Config) WithParent(parentLinterName string) *Config {
 	return lc
 }
 
+func (lc *Config) WithObsoleteSinceGo(version string) *Config {
+	lc.ObsoleteSinceGo = version
+	return lc
+}
+
 func (lc *Config) GetSpeed() int {
 	return lc.Speed
 }
 
+const (
+	maxSpeed = 10
+
+	typeInfoCost = 3 // loading of types is shared but type-aware linters do more work
+	ssaCost      = 5 // SSA linters analyze the whole program
+)
+
+// GetCost estimates how long the linter runs compared to other linters by its speed
+// and what it needs: more value means longer execution.
+func (lc *Config) GetCost() int {
+	cost := maxSpeed - lc.Speed
+	if lc.NeedsTypeInfo {
+		cost += typeInfoCost
+	}
+	if lc.NeedsSSARepr {
+		cost += ssaCost
+	}
+
+	return cost
+}
+
 func (lc *Config) AllNames() []string {
 	return append([]string{lc.Name()}, lc.AlternativeNames...)
 }
@@ -22,6 +22,8 @@ type Context struct {
 	Cfg      *config.Config
 	ASTCache *astcache.Cache
 	Log      logutils.Log
+
+	GoVersion string // targeted Go version in the form 1.N, "" if it's unknown
 }
 
 func (c *Context) Settings() *config.LintersSettings {
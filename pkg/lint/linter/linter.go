@@ -11,3 +11,15 @@ type Linter interface {
 	Name() string
 	Desc() string
 }
+
+// Check is a check of a linter: issues of the check can be reported by the linter.
+type Check struct {
+	ID   string
+	Desc string `json:",omitempty"`
+}
+
+// CheckLister is implemented by linters having a registry of checks, e.g. gosec
+// or staticcheck.
+type CheckLister interface {
+	Checks() []Check
+}
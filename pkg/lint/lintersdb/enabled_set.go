@@ -3,24 +3,29 @@ package lintersdb
 import (
 	"sort"
 
+	"github.com/pkg/errors"
+
 	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/goutil"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 )
 
 type EnabledSet struct {
-	m   *Manager
-	v   *Validator
-	log logutils.Log
-	cfg *config.Config
+	m     *Manager
+	v     *Validator
+	log   logutils.Log
+	cfg   *config.Config
+	goenv *goutil.Env
 }
 
-func NewEnabledSet(m *Manager, v *Validator, log logutils.Log, cfg *config.Config) *EnabledSet {
+func NewEnabledSet(m *Manager, v *Validator, log logutils.Log, cfg *config.Config, goenv *goutil.Env) *EnabledSet {
 	return &EnabledSet{
-		m:   m,
-		v:   v,
-		log: log,
-		cfg: cfg,
+		m:     m,
+		v:     v,
+		log:   log,
+		cfg:   cfg,
+		goenv: goenv,
 	}
 }
 
@@ -115,12 +120,49 @@ func (es EnabledSet) optimizeLintersSet(linters map[string]*linter.Config) {
 	}
 }
 
+// disableObsoleteLinters removes linters obsolete for the targeted Go version,
+// e.g. scopelint since go 1.22 fixed semantics of loop variables.
+func (es EnabledSet) disableObsoleteLinters(linters map[string]*linter.Config, goVersion string) {
+	targetMinor, err := goutil.ParseMinorVersion(goVersion)
+	if err != nil {
+		return // unknown version
+	}
+
+	explicitlyEnabled := map[string]bool{}
+	for _, name := range es.cfg.Linters.Enable {
+		explicitlyEnabled[name] = true
+	}
+
+	for name, lc := range linters {
+		obsoleteMinor, err := goutil.ParseMinorVersion(lc.ObsoleteSinceGo)
+		if lc.ObsoleteSinceGo == "" || err != nil || targetMinor < obsoleteMinor {
+			continue
+		}
+
+		logf := es.log.Infof
+		for _, n := range lc.AllNames() {
+			if explicitlyEnabled[n] {
+				logf = es.log.Warnf
+			}
+		}
+		logf("Linter %s is disabled: it's obsolete since go %s, targeted go version is %s",
+			lc.Name(), lc.ObsoleteSinceGo, goVersion)
+		delete(linters, name)
+	}
+}
+
 func (es EnabledSet) Get(optimize bool) ([]*linter.Config, error) {
 	if err := es.v.validateEnabledDisabledLintersConfig(&es.cfg.Linters); err != nil {
 		return nil, err
 	}
 
+	goVersion, err := goutil.Version(es.cfg.Run.Go, es.goenv.Get("GOMOD"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid run.go option")
+	}
+
 	resultLintersSet := es.build(&es.cfg.Linters, es.m.GetAllEnabledByDefaultLinters())
+	es.disableObsoleteLinters(resultLintersSet, goVersion)
 	es.verbosePrintLintersStatus(resultLintersSet)
 	if optimize {
 		es.optimizeLintersSet(resultLintersSet)
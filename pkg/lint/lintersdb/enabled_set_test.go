@@ -10,6 +10,7 @@ import (
 
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/logutils"
 )
 
 func TestGetEnabledLintersSet(t *testing.T) {
@@ -92,7 +93,7 @@ func TestGetEnabledLintersSet(t *testing.T) {
 	}
 
 	m := NewManager()
-	es := NewEnabledSet(m, NewValidator(m), nil, nil)
+	es := NewEnabledSet(m, NewValidator(m), nil, nil, nil)
 	for _, c := range cases {
 		c := c
 		t.Run(c.name, func(t *testing.T) {
@@ -117,3 +118,29 @@ func TestGetEnabledLintersSet(t *testing.T) {
 		})
 	}
 }
+
+func TestDisableObsoleteLinters(t *testing.T) {
+	cfg := config.NewDefault()
+	cfg.Linters.Enable = []string{"scopelint"}
+
+	m := NewManager()
+	es := NewEnabledSet(m, NewValidator(m), logutils.NewStderrLog("lintersdb"), cfg, nil)
+	enabled := func(goVersion string) []string {
+		linters := map[string]*linter.Config{}
+		for _, name := range []string{"scopelint", "gofmt"} {
+			linters[name] = m.GetLinterConfig(name)
+		}
+		es.disableObsoleteLinters(linters, goVersion)
+
+		var names []string
+		for name := range linters {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	assert.Equal(t, []string{"gofmt"}, enabled("1.22"))
+	assert.Equal(t, []string{"gofmt", "scopelint"}, enabled("1.21"))
+	assert.Equal(t, []string{"gofmt", "scopelint"}, enabled(""))
+}
@@ -160,6 +160,107 @@ func (Manager) GetAllSupportedLinterConfigs() []*linter.Config {
 			WithPresets(linter.PresetComplexity).
 			WithSpeed(8).
 			WithURL("https://github.com/alecthomas/gocyclo"),
+		linter.NewConfig(golinters.Cyclop{}).
+			WithPresets(linter.PresetComplexity).
+			WithSpeed(8).
+			WithURL("https://github.com/bkielbasa/cyclop"),
+		linter.NewConfig(golinters.Tagliatelle{}).
+			WithPresets(linter.PresetStyle).
+			WithSpeed(10).
+			WithURL("https://github.com/ldez/tagliatelle"),
+		linter.NewConfig(golinters.Promlinter{}).
+			WithPresets(linter.PresetStyle).
+			WithSpeed(10).
+			WithURL("https://github.com/yeya24/promlinter"),
+		linter.NewConfig(golinters.Containedctx{}).
+			WithPresets(linter.PresetStyle).
+			WithSpeed(10).
+			WithURL("https://github.com/sivchari/containedctx"),
+		linter.NewConfig(golinters.Mnd{}).
+			WithPresets(linter.PresetStyle).
+			WithSpeed(10).
+			WithURL("https://github.com/tommy-muehle/go-mnd"),
+		linter.NewConfig(golinters.Paralleltest{}).
+			WithPresets(linter.PresetStyle).
+			WithSpeed(10).
+			WithURL("https://github.com/kunwardeep/paralleltest"),
+		linter.NewConfig(golinters.Testpackage{}).
+			WithPresets(linter.PresetStyle).
+			WithSpeed(10).
+			WithURL("https://github.com/maratori/testpackage"),
+		linter.NewConfig(golinters.Gocognit{}).
+			WithPresets(linter.PresetComplexity).
+			WithSpeed(8).
+			WithURL("https://github.com/uudashr/gocognit"),
+		linter.NewConfig(golinters.Sqlclosecheck{}).
+			WithSSA().
+			WithPresets(linter.PresetBugs).
+			WithSpeed(4).
+			WithURL("https://github.com/ryanrolds/sqlclosecheck"),
+		linter.NewConfig(golinters.Musttag{}).
+			WithTypeInfo().
+			WithPresets(linter.PresetStyle).
+			WithSpeed(7).
+			WithURL("https://github.com/junk1tm/musttag"),
+		linter.NewConfig(golinters.Exportloopref{}).
+			WithPresets(linter.PresetBugs).
+			WithSpeed(9).
+			WithObsoleteSinceGo("1.22"). // loop variables are per-iteration since go 1.22
+			WithURL("https://github.com/kyoh86/exportloopref"),
+		linter.NewConfig(golinters.Bidichk{}).
+			WithPresets(linter.PresetBugs).
+			WithSpeed(10).
+			WithURL("https://github.com/breml/bidichk"),
+		linter.NewConfig(golinters.Wrapcheck{}).
+			WithTypeInfo().
+			WithPresets(linter.PresetStyle).
+			WithSpeed(7).
+			WithURL("https://github.com/tomarrell/wrapcheck"),
+		linter.NewConfig(golinters.Goerr113{}).
+			WithTypeInfo().
+			WithPresets(linter.PresetStyle).
+			WithSpeed(8).
+			WithURL("https://github.com/Djarvur/go-err113"),
+		linter.NewConfig(golinters.Nilnil{}).
+			WithTypeInfo().
+			WithPresets(linter.PresetStyle).
+			WithSpeed(8).
+			WithURL("https://github.com/Antonboom/nilnil"),
+		linter.NewConfig(golinters.Dupword{}).
+			WithPresets(linter.PresetStyle).
+			WithSpeed(10).
+			WithURL("https://github.com/Abirdcfly/dupword"),
+		linter.NewConfig(golinters.Varnamelen{}).
+			WithPresets(linter.PresetStyle).
+			WithSpeed(10).
+			WithURL("https://github.com/blizzy78/varnamelen"),
+		linter.NewConfig(golinters.Importas{}).
+			WithPresets(linter.PresetStyle).
+			WithSpeed(10).
+			WithURL("https://github.com/julz/importas"),
+		linter.NewConfig(golinters.Tenv{}).
+			WithPresets(linter.PresetStyle).
+			WithSpeed(10).
+			WithURL("https://github.com/sivchari/tenv"),
+		linter.NewConfig(golinters.Gochecksumtype{}).
+			WithTypeInfo().
+			WithPresets(linter.PresetBugs).
+			WithSpeed(8).
+			WithURL("https://github.com/BurntSushi/go-sumtype"),
+		linter.NewConfig(golinters.Protogetter{}).
+			WithTypeInfo().
+			WithPresets(linter.PresetBugs).
+			WithSpeed(8).
+			WithURL("https://github.com/ghostiam/protogetter"),
+		linter.NewConfig(golinters.Loggercheck{}).
+			WithTypeInfo().
+			WithPresets(linter.PresetBugs).
+			WithSpeed(9).
+			WithURL("https://github.com/timonwong/loggercheck"),
+		linter.NewConfig(golinters.Maintidx{}).
+			WithPresets(linter.PresetComplexity).
+			WithSpeed(8).
+			WithURL("https://github.com/yagipy/maintidx"),
 		linter.NewConfig(golinters.TypeCheck{}).
 			WithTypeInfo().
 			WithPresets(linter.PresetBugs).
@@ -208,6 +309,7 @@ func (Manager) GetAllSupportedLinterConfigs() []*linter.Config {
 		linter.NewConfig(golinters.Scopelint{}).
 			WithPresets(linter.PresetBugs).
 			WithSpeed(8).
+			WithObsoleteSinceGo("1.22"). // loop variables are per-iteration since go 1.22
 			WithURL("https://github.com/kyoh86/scopelint"),
 		linter.NewConfig(golinters.Gocritic{}).
 			WithPresets(linter.PresetStyle).
@@ -222,6 +324,36 @@ func (Manager) GetAllSupportedLinterConfigs() []*linter.Config {
 			WithPresets(linter.PresetStyle).
 			WithSpeed(10).
 			WithURL("https://github.com/leighmcculloch/gochecknoglobals"),
+		linter.NewConfig(golinters.Usestdlibvars{}).
+			WithPresets(linter.PresetStyle).
+			WithSpeed(10).
+			WithURL("https://github.com/sashamelentyev/usestdlibvars"),
+		linter.NewConfig(golinters.Decorder{}).
+			WithPresets(linter.PresetStyle).
+			WithSpeed(10).
+			WithURL("https://gitlab.com/bosi/decorder"),
+		linter.NewConfig(golinters.Nolintlint{}).
+			WithPresets(linter.PresetStyle).
+			WithSpeed(10).
+			WithURL("https://github.com/golangci/golangci-lint/tree/master/pkg/golinters/nolintlint.go"),
+		linter.NewConfig(golinters.Reassign{}).
+			WithPresets(linter.PresetBugs).
+			WithSpeed(10).
+			WithURL("https://github.com/curioswitch/go-reassign"),
+		linter.NewConfig(golinters.Gosmopolitan{}).
+			WithPresets(linter.PresetBugs).
+			WithSpeed(10).
+			WithURL("https://github.com/xen0n/gosmopolitan"),
+		linter.NewConfig(golinters.Errorlint{}).
+			WithTypeInfo().
+			WithPresets(linter.PresetBugs).
+			WithSpeed(8).
+			WithURL("https://github.com/polyfloyd/go-errorlint"),
+		linter.NewConfig(golinters.Wastedassign{}).
+			WithTypeInfo().
+			WithPresets(linter.PresetStyle).
+			WithSpeed(5).
+			WithURL("https://github.com/sanposhiho/wastedassign"),
 	}
 
 	isLocalRun := os.Getenv("GOLANGCI_COM_RUN") == ""
@@ -20,6 +20,10 @@ func NewValidator(m *Manager) *Validator {
 func (v Validator) validateLintersNames(cfg *config.Linters) error {
 	allNames := append([]string{}, cfg.Enable...)
 	allNames = append(allNames, cfg.Disable...)
+	for _, o := range cfg.Overrides {
+		allNames = append(allNames, o.Enable...)
+		allNames = append(allNames, o.Disable...)
+	}
 	for _, name := range allNames {
 		if v.m.GetLinterConfig(name) == nil && v.m.GetMetaLinter(name) == nil {
 			return fmt.Errorf("no such linter %q", name)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"go/build"
 	"go/types"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/exitcodes"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/goutil"
 	"github.com/golangci/golangci-lint/pkg/lint/astcache"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
@@ -32,6 +34,8 @@ type ContextLoader struct {
 	debugf      logutils.DebugFunc
 	goenv       *goutil.Env
 	pkgTestIDRe *regexp.Regexp
+
+	loadProgressInterval time.Duration
 }
 
 func NewContextLoader(cfg *config.Config, log logutils.Log, goenv *goutil.Env) *ContextLoader {
@@ -41,6 +45,8 @@ func NewContextLoader(cfg *config.Config, log logutils.Log, goenv *goutil.Env) *
 		debugf:      logutils.Debug("loader"),
 		goenv:       goenv,
 		pkgTestIDRe: regexp.MustCompile(`^(.*) \[(.*)\.test\]`),
+
+		loadProgressInterval: defaultLoadProgressInterval,
 	}
 }
 
@@ -174,10 +180,33 @@ func stringifyLoadMode(mode packages.LoadMode) string {
 	return "unknown"
 }
 
-func (cl ContextLoader) buildArgs() []string {
+func (cl ContextLoader) buildArgs() ([]string, error) {
 	args := cl.cfg.Run.Args
-	if len(args) == 0 {
-		return []string{"./..."}
+
+	var importPaths []string
+	if cl.cfg.Run.PackagesFromFile != "" {
+		filePatterns, err := readPackagesFile(cl.cfg.Run.PackagesFromFile)
+		if err != nil {
+			return nil, err
+		}
+
+		args = append([]string{}, args...)
+		for _, p := range filePatterns {
+			if strings.HasPrefix(p, ".") || filepath.IsAbs(p) {
+				args = append(args, p)
+			} else {
+				importPaths = append(importPaths, p) // unlike args they aren't directories
+			}
+		}
+	}
+
+	if len(args) == 0 && len(importPaths) == 0 {
+		args = []string{"./..."}
+	}
+
+	args, err := cl.expandRecursiveArgs(args)
+	if err != nil {
+		return nil, err
 	}
 
 	var retArgs []string
@@ -190,7 +219,68 @@ func (cl ContextLoader) buildArgs() []string {
 		}
 	}
 
-	return retArgs
+	return append(retArgs, importPaths...), nil
+}
+
+// readPackagesFile reads newline-separated patterns of packages skipping empty lines and # comments.
+func readPackagesFile(path string) ([]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't read packages-from-file")
+	}
+
+	var patterns []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, line)
+	}
+
+	return patterns, nil
+}
+
+// expandRecursiveArgs replaces recursive args like ./... with the list of
+// directories found by our walker if walking limits are set: go list
+// can't be told to not walk some directories.
+func (cl ContextLoader) expandRecursiveArgs(args []string) ([]string, error) {
+	maxDepth := cl.cfg.Run.MaxWalkDepth
+	if maxDepth <= 0 && len(cl.cfg.Run.PruneDirs) == 0 {
+		return args, nil
+	}
+
+	var prune []*regexp.Regexp
+	for _, p := range cl.cfg.Run.PruneDirs {
+		pRe, err := regexp.Compile(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't compile prune-dirs regexp %q", p)
+		}
+		prune = append(prune, pRe)
+	}
+
+	var retArgs []string
+	for _, arg := range args {
+		if filepath.Base(arg) != "..." {
+			retArgs = append(retArgs, arg)
+			continue
+		}
+
+		root := filepath.Dir(arg)
+		dirs, err := fsutils.FindGoDirs(root, maxDepth, prune)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to walk %s", root)
+		}
+		cl.debugf("Expanded arg %s to %d dirs", arg, len(dirs))
+
+		retArgs = append(retArgs, dirs...)
+	}
+
+	if len(retArgs) == 0 {
+		return nil, exitcodes.ErrNoGoFiles
+	}
+
+	return retArgs, nil
 }
 
 func (cl ContextLoader) makeBuildFlags() ([]string, error) {
@@ -234,21 +324,35 @@ func (cl ContextLoader) loadPackages(ctx context.Context, loadMode packages.Load
 		return nil, errors.Wrap(err, "failed to make build flags for go list")
 	}
 
+	args, err := cl.buildArgs()
+	if err != nil {
+		return nil, err
+	}
+	cl.debugf("Built loader args are %s", args)
+
+	progress := newLoadProgress(cl.log, cl.loadProgressInterval)
+	progress.start()
+	defer progress.stop()
+
+	if cl.cfg.Run.IsVerbose && loadMode >= packages.LoadTypes {
+		cl.listPackages(ctx, progress, loadMode, buildFlags, args)
+	}
+
 	conf := &packages.Config{
 		Mode:       loadMode,
 		Tests:      cl.cfg.Run.AnalyzeTests,
 		Context:    ctx,
 		BuildFlags: buildFlags,
-		//TODO: use fset, parsefile, overlay
+		Overlay:    cl.cfg.Run.Overlay,
+		ParseFile:  progress.parseFile,
+		//TODO: use fset
 	}
-
-	args := cl.buildArgs()
-	cl.debugf("Built loader args are %s", args)
 	pkgs, err := packages.Load(conf, args...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load program with go/packages")
 	}
 	cl.debugf("loaded %d pkgs", len(pkgs))
+	progress.finish(len(pkgs))
 	for i, pkg := range pkgs {
 		var syntaxFiles []string
 		for _, sf := range pkg.Syntax {
@@ -258,6 +362,11 @@ func (cl ContextLoader) loadPackages(ctx context.Context, loadMode packages.Load
 			i, pkg.ID, pkg.GoFiles, pkg.CompiledGoFiles, syntaxFiles)
 	}
 
+	pkgs, err = cl.skipMissingPackages(pkgs)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, pkg := range pkgs {
 		for _, err := range pkg.Errors {
 			if strings.Contains(err.Msg, "no Go files") {
@@ -266,7 +375,100 @@ func (cl ContextLoader) loadPackages(ctx context.Context, loadMode packages.Load
 		}
 	}
 
-	return cl.filterPackages(pkgs), nil
+	return cl.filterPackagesByPattern(cl.filterPackages(pkgs))
+}
+
+// listPackages lists packages by go list without type info to count packages in the progress of loading.
+func (cl ContextLoader) listPackages(ctx context.Context, progress *loadProgress, loadMode packages.LoadMode,
+	buildFlags, args []string) {
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:       packages.LoadImports,
+		Tests:      cl.cfg.Run.AnalyzeTests,
+		Context:    ctx,
+		BuildFlags: buildFlags,
+	}, args...)
+	if err != nil {
+		cl.log.Warnf("Failed to list packages to log the progress of loading: %s", err)
+		return
+	}
+
+	progress.setListedPackages(pkgs, loadMode, len(cl.cfg.Run.Overlay) != 0)
+}
+
+// filterPackagesByPattern leaves only packages with import paths matching package-filter.
+func (cl ContextLoader) filterPackagesByPattern(pkgs []*packages.Package) ([]*packages.Package, error) {
+	if cl.cfg.Run.PackageFilter == "" {
+		return pkgs, nil
+	}
+
+	re, err := regexp.Compile(cl.cfg.Run.PackageFilter)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't compile package-filter regexp %q", cl.cfg.Run.PackageFilter)
+	}
+
+	var retPkgs []*packages.Package
+	for _, pkg := range pkgs {
+		if re.MatchString(pkg.PkgPath) {
+			retPkgs = append(retPkgs, pkg)
+		} else {
+			cl.debugf("skip pkg ID=%s because it doesn't match package filter", pkg.ID)
+		}
+	}
+	cl.log.Infof("Package filter left %d of %d packages", len(retPkgs), len(pkgs))
+
+	return retPkgs, nil
+}
+
+// skipMissingPackages removes packages without Go files matching skip-missing-packages:
+// packages generated by the build don't exist in a clean checkout.
+func (cl ContextLoader) skipMissingPackages(pkgs []*packages.Package) ([]*packages.Package, error) {
+	if len(cl.cfg.Run.SkipMissingPackages) == 0 {
+		return pkgs, nil
+	}
+
+	var patterns []*regexp.Regexp
+	for _, p := range cl.cfg.Run.SkipMissingPackages {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't compile skip-missing-packages regexp %q", p)
+		}
+		patterns = append(patterns, re)
+	}
+
+	var retPkgs []*packages.Package
+	for _, pkg := range pkgs {
+		if isMissingPackage(pkg) && matchAnyRegexp(patterns, filepath.ToSlash(pkg.PkgPath)) {
+			cl.log.Infof("Skipped missing package %s", pkg.PkgPath)
+			continue
+		}
+		retPkgs = append(retPkgs, pkg)
+	}
+
+	return retPkgs, nil
+}
+
+func isMissingPackage(pkg *packages.Package) bool {
+	if len(pkg.GoFiles) != 0 {
+		return false
+	}
+
+	for _, err := range pkg.Errors {
+		if strings.Contains(err.Msg, "no Go files") || strings.Contains(err.Msg, "cannot find package") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchAnyRegexp(patterns []*regexp.Regexp, s string) bool {
+	for _, re := range patterns {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (cl ContextLoader) tryParseTestPackage(pkg *packages.Package) (name, testName string, isTest bool) {
@@ -315,8 +517,37 @@ func (cl ContextLoader) filterPackages(pkgs []*packages.Package) []*packages.Pac
 	return retPkgs
 }
 
+// Plan is what would be loaded and linted: it's printed in the dry run mode.
+type Plan struct {
+	LoadMode string
+	Packages []*packages.Package
+}
+
+// Plan finds packages and files to lint without loading of types and syntax.
+func (cl ContextLoader) Plan(ctx context.Context, linters []*linter.Config) (*Plan, error) {
+	pkgs, err := cl.loadPackages(ctx, packages.LoadFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pkgs) == 0 {
+		return nil, exitcodes.ErrNoGoFiles
+	}
+
+	return &Plan{
+		LoadMode: stringifyLoadMode(cl.findLoadMode(linters)),
+		Packages: pkgs,
+	}, nil
+}
+
 //nolint:gocyclo
 func (cl ContextLoader) Load(ctx context.Context, linters []*linter.Config) (*linter.Context, error) {
+	goVersion, err := goutil.Version(cl.cfg.Run.Go, cl.goenv.Get("GOMOD"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid run.go option")
+	}
+	cl.debugf("Targeted go version is %q", goVersion)
+
 	loadMode := cl.findLoadMode(linters)
 	pkgs, err := cl.loadPackages(ctx, loadMode)
 	if err != nil {
@@ -338,7 +569,7 @@ func (cl ContextLoader) Load(ctx context.Context, linters []*linter.Config) (*li
 	}
 
 	astLog := cl.log.Child("astcache")
-	astCache, err := astcache.LoadFromPackages(pkgs, astLog)
+	astCache, err := astcache.LoadFromPackages(pkgs, cl.cfg.Run.MaxOpenFiles, cl.cfg.Run.BestEffortAST, cl.cfg.Run.Overlay, astLog)
 	if err != nil {
 		return nil, err
 	}
@@ -351,9 +582,10 @@ func (cl ContextLoader) Load(ctx context.Context, linters []*linter.Config) (*li
 			Cwd:   "",  // used by depguard and fallbacked to os.Getcwd
 			Build: nil, // used by depguard and megacheck and fallbacked to build.Default
 		},
-		Cfg:      cl.cfg,
-		ASTCache: astCache,
-		Log:      cl.log,
+		Cfg:       cl.cfg,
+		ASTCache:  astCache,
+		Log:       cl.log,
+		GoVersion: goVersion,
 	}
 
 	if prog != nil {
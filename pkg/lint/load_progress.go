@@ -0,0 +1,167 @@
+package lint
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+const defaultLoadProgressInterval = 2 * time.Second
+
+// loadProgress logs the progress of loading by go/packages at most once per interval:
+// loading of large repos takes minutes. While go list runs it logs how long it runs,
+// then it logs the count of loaded packages of the total count of packages listed by go list.
+// A package is loaded when all its files are parsed.
+type loadProgress struct {
+	log      logutils.Log
+	interval time.Duration
+	done     chan struct{}
+	stopOnce sync.Once
+
+	mu           sync.Mutex
+	startedAt    time.Time
+	stopped      bool
+	pkgFiles     map[string]map[string]bool // unparsed files by package ids, nil until packages are listed
+	filePkgs     map[string][]string        // ids of packages by their files
+	loadedPkgs   int
+	pkgDirs      map[string]bool
+	parsedFiles  int
+	lastReportAt time.Time
+}
+
+func newLoadProgress(log logutils.Log, interval time.Duration) *loadProgress {
+	now := time.Now()
+	return &loadProgress{
+		log:          log,
+		interval:     interval,
+		done:         make(chan struct{}),
+		startedAt:    now,
+		pkgDirs:      map[string]bool{},
+		lastReportAt: now,
+	}
+}
+
+// start logs that go list is still running until the first file is parsed or the progress is stopped.
+func (p *loadProgress) start() {
+	if p.interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-p.done:
+				return
+			case <-ticker.C:
+				p.mu.Lock()
+				if !p.stopped && p.parsedFiles == 0 {
+					p.lastReportAt = time.Now()
+					p.log.Infof("Loading packages: go list is running for %s", time.Since(p.startedAt).Round(time.Second))
+				}
+				p.mu.Unlock()
+			}
+		}
+	}()
+}
+
+// setListedPackages sets packages listed by go list with their dependencies:
+// only packages which files are going to be parsed are counted.
+func (p *loadProgress) setListedPackages(pkgs []*packages.Package, loadMode packages.LoadMode, withOverlay bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.pkgFiles = map[string]map[string]bool{}
+	p.filePkgs = map[string][]string{}
+	add := func(pkg *packages.Package) {
+		files := map[string]bool{}
+		for _, f := range pkg.CompiledGoFiles {
+			files[f] = true
+			p.filePkgs[f] = append(p.filePkgs[f], pkg.ID)
+		}
+		p.pkgFiles[pkg.ID] = files
+		if len(files) == 0 {
+			p.loadedPkgs++
+		}
+	}
+
+	if loadMode < packages.LoadAllSyntax && !withOverlay { // only roots are parsed, dependencies are loaded from export data
+		for _, pkg := range pkgs {
+			add(pkg)
+		}
+		return
+	}
+
+	packages.Visit(pkgs, nil, add)
+}
+
+// parseFile is packages.Config.ParseFile: it parses the file like go/packages does by default.
+func (p *loadProgress) parseFile(fset *token.FileSet, filename string, src []byte) (*ast.File, error) {
+	p.track(filename)
+
+	var isrc interface{}
+	if src != nil {
+		isrc = src
+	}
+	return parser.ParseFile(fset, filename, isrc, parser.AllErrors|parser.ParseComments)
+}
+
+func (p *loadProgress) track(filename string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.parsedFiles++
+	p.pkgDirs[filepath.Dir(filename)] = true
+
+	loadedPkgs := p.loadedPkgs
+	for _, id := range p.filePkgs[filename] {
+		files := p.pkgFiles[id]
+		if !files[filename] {
+			continue
+		}
+
+		delete(files, filename)
+		if len(files) == 0 {
+			p.loadedPkgs++
+		}
+	}
+
+	if p.loadedPkgs != loadedPkgs && time.Since(p.lastReportAt) >= p.interval {
+		p.lastReportAt = time.Now()
+		p.log.Infof("Loading packages: loaded %d/%d packages", p.loadedPkgs, len(p.pkgFiles))
+	}
+}
+
+func (p *loadProgress) stop() {
+	p.stopOnce.Do(func() {
+		p.mu.Lock()
+		p.stopped = true
+		p.mu.Unlock()
+
+		close(p.done)
+	})
+}
+
+func (p *loadProgress) finish(loadedPkgs int) {
+	p.stop()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.parsedFiles == 0 { // files are parsed by go/packages only for type info
+		p.log.Infof("Loaded %d packages", loadedPkgs)
+		return
+	}
+
+	p.log.Infof("Loaded %d packages: parsed %d files of %d packages with dependencies",
+		loadedPkgs, p.parsedFiles, len(p.pkgDirs))
+}
@@ -0,0 +1,189 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/golinters"
+	"github.com/golangci/golangci-lint/pkg/goutil"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func TestLoadOverlay(t *testing.T) {
+	filename, err := filepath.Abs(filepath.Join("testdata", "overlay", "overlay.go"))
+	require.NoError(t, err)
+
+	log := logutils.NewStderrLog("")
+	ctx := context.Background()
+	goenv := goutil.NewEnv(log)
+	require.NoError(t, goenv.Discover(ctx))
+
+	decorder := golinters.Decorder{}
+	linters := []*linter.Config{linter.NewConfig(decorder)}
+
+	load := func(overlay map[string][]byte) *linter.Context {
+		cfg := config.NewDefault()
+		cfg.Run.Args = []string{"./testdata/overlay"}
+		cfg.Run.Overlay = overlay
+
+		lintCtx, err := NewContextLoader(cfg, log, goenv).Load(ctx, linters)
+		require.NoError(t, err)
+		return lintCtx
+	}
+
+	issues, err := decorder.Run(ctx, load(nil))
+	require.NoError(t, err)
+	assert.Empty(t, issues) // no issue on disk
+
+	issues, err = decorder.Run(ctx, load(map[string][]byte{
+		filename: []byte("package overlay\n\nfunc F() {}\n\ntype T int\n"),
+	}))
+	require.NoError(t, err)
+	if assert.Len(t, issues, 1) {
+		assert.Equal(t, filename, issues[0].FilePath())
+		assert.Equal(t, 5, issues[0].Line())
+	}
+}
+
+func TestLoadPackagesFromFile(t *testing.T) {
+	listFile, err := ioutil.TempFile("", "packages")
+	require.NoError(t, err)
+	defer os.Remove(listFile.Name())
+
+	_, err = listFile.WriteString("# packages to lint\n./testdata/packages_from_file/a\n\n" +
+		"github.com/golangci/golangci-lint/pkg/lint/testdata/packages_from_file/b\n")
+	require.NoError(t, err)
+	require.NoError(t, listFile.Close())
+
+	log := logutils.NewStderrLog("")
+	ctx := context.Background()
+	goenv := goutil.NewEnv(log)
+	require.NoError(t, goenv.Discover(ctx))
+
+	cfg := config.NewDefault()
+	cfg.Run.PackagesFromFile = listFile.Name()
+
+	linters := []*linter.Config{linter.NewConfig(golinters.Decorder{})}
+	lintCtx, err := NewContextLoader(cfg, log, goenv).Load(ctx, linters)
+	require.NoError(t, err)
+
+	var pkgPaths []string
+	for _, pkg := range lintCtx.Packages {
+		pkgPaths = append(pkgPaths, pkg.PkgPath)
+	}
+	assert.ElementsMatch(t, []string{
+		"github.com/golangci/golangci-lint/pkg/lint/testdata/packages_from_file/a",
+		"github.com/golangci/golangci-lint/pkg/lint/testdata/packages_from_file/b",
+	}, pkgPaths)
+}
+
+func TestLoadProgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var messages []string
+	log := logutils.NewMockLog(ctrl)
+	log.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes().Do(func(format string, args ...interface{}) {
+		messages = append(messages, fmt.Sprintf(format, args...))
+	})
+	log.EXPECT().Child(gomock.Any()).AnyTimes().Return(log)
+
+	ctx := context.Background()
+	goenv := goutil.NewEnv(logutils.NewStderrLog(""))
+	require.NoError(t, goenv.Discover(ctx))
+
+	cfg := config.NewDefault()
+	cfg.Run.Args = []string{"./testdata/packages_from_file/..."}
+	cfg.Run.IsVerbose = true
+
+	loader := NewContextLoader(cfg, log, goenv)
+	loader.loadProgressInterval = 0 // report every package
+	_, err := loader.Load(ctx, []*linter.Config{linter.NewConfig(golinters.Errorlint{}).WithTypeInfo()})
+	require.NoError(t, err)
+
+	assert.Contains(t, messages, "Loading packages: loaded 1/3 packages")
+	assert.Contains(t, messages, "Loading packages: loaded 3/3 packages")
+	assert.Contains(t, messages, "Loaded 3 packages: parsed 3 files of 3 packages with dependencies")
+}
+
+func TestLoadProgressWhileListing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var mu sync.Mutex
+	var messages []string
+	log := logutils.NewMockLog(ctrl)
+	log.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes().Do(func(format string, args ...interface{}) {
+		mu.Lock()
+		defer mu.Unlock()
+		messages = append(messages, fmt.Sprintf(format, args...))
+	})
+
+	progress := newLoadProgress(log, 10*time.Millisecond)
+	progress.start()
+	time.Sleep(100 * time.Millisecond) // go list runs
+	progress.finish(0)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if assert.True(t, len(messages) > 1) {
+		assert.Equal(t, "Loading packages: go list is running for 0s", messages[0])
+		assert.Equal(t, "Loaded 0 packages", messages[len(messages)-1])
+	}
+}
+
+func TestLoadSkipMissingPackages(t *testing.T) {
+	log := logutils.NewStderrLog("")
+	ctx := context.Background()
+	goenv := goutil.NewEnv(log)
+	require.NoError(t, goenv.Discover(ctx))
+
+	load := func(skipMissing []string) (*linter.Context, error) {
+		cfg := config.NewDefault()
+		cfg.Run.Args = []string{"./testdata/packages_from_file/a", "./testdata/skip_missing/mocks"}
+		cfg.Run.SkipMissingPackages = skipMissing
+
+		return NewContextLoader(cfg, log, goenv).Load(ctx, []*linter.Config{linter.NewConfig(golinters.Decorder{})})
+	}
+
+	_, err := load(nil)
+	assert.Error(t, err, "a package without Go files fails the run")
+
+	lintCtx, err := load([]string{"/mocks$"})
+	require.NoError(t, err)
+	if assert.Len(t, lintCtx.Packages, 1) {
+		assert.Equal(t, "github.com/golangci/golangci-lint/pkg/lint/testdata/packages_from_file/a", lintCtx.Packages[0].PkgPath)
+	}
+}
+
+func TestLoadPackageFilter(t *testing.T) {
+	log := logutils.NewStderrLog("")
+	ctx := context.Background()
+	goenv := goutil.NewEnv(log)
+	require.NoError(t, goenv.Discover(ctx))
+
+	cfg := config.NewDefault()
+	cfg.Run.Args = []string{"./testdata/package_filter/..."}
+	cfg.Run.PackageFilter = "/internal/"
+
+	lintCtx, err := NewContextLoader(cfg, log, goenv).Load(ctx, []*linter.Config{linter.NewConfig(golinters.Decorder{})})
+	require.NoError(t, err)
+	if assert.Len(t, lintCtx.Packages, 1) {
+		assert.Equal(t, "github.com/golangci/golangci-lint/pkg/lint/testdata/package_filter/internal/a", lintCtx.Packages[0].PkgPath)
+	}
+	for _, f := range lintCtx.ASTCache.GetAllValidFiles() {
+		assert.Contains(t, filepath.ToSlash(f.Name), "/internal/")
+	}
+}
@@ -0,0 +1,66 @@
+package lint
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// mergedReport is a pseudo linter returning issues of the external report in the JSON format of golangci-lint,
+// e.g. produced by non-Go analyzers: its issues are processed and printed with issues of linters.
+type mergedReport struct {
+	path   string
+	issues []result.Issue
+}
+
+// loadMergedReport reads the report before linters are run: the run must fail instead of
+// silently dropping issues of an unreadable report.
+func loadMergedReport(path string) (*mergedReport, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't read merged report")
+	}
+
+	var report struct {
+		Issues []result.Issue
+	}
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, errors.Wrapf(err, "can't decode merged report %s", path)
+	}
+
+	return &mergedReport{path: path, issues: report.Issues}, nil
+}
+
+func (r mergedReport) Name() string {
+	return "merged report " + r.path
+}
+
+func (mergedReport) Desc() string {
+	return "Issues of the external report in the JSON format of golangci-lint"
+}
+
+func (r mergedReport) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	return r.issues, nil
+}
+
+// mergeReport passes results of linters and issues of the report: they aren't stored to the fix cache.
+func (r Runner) mergeReport(inCh <-chan lintRes) <-chan lintRes {
+	outCh := make(chan lintRes, 64)
+
+	go func() {
+		defer close(outCh)
+
+		outCh <- lintRes{linter: linter.NewConfig(r.mergedReport), issues: r.mergedReport.issues}
+
+		for res := range inCh {
+			outCh <- res
+		}
+	}()
+
+	return outCh
+}
@@ -3,16 +3,21 @@ package lint
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"runtime/debug"
 	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/golinters"
 	"github.com/golangci/golangci-lint/pkg/goutil"
 	"github.com/golangci/golangci-lint/pkg/lint/astcache"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/packages"
 	"github.com/golangci/golangci-lint/pkg/result"
@@ -23,9 +28,17 @@ import (
 type Runner struct {
 	Processors []processors.Processor
 	Log        logutils.Log
+
+	mergedReport *mergedReport
 }
 
 func NewRunner(astCache *astcache.Cache, cfg *config.Config, log logutils.Log, goenv *goutil.Env) (*Runner, error) {
+	if cfg.Output.MinimalProcessing && cfg.Output.FingerprintMode == config.FingerprintModeChecksumContext {
+		// fingerprints would silently fall back to the text mode without source lines of issues
+		return nil, fmt.Errorf("--minimal-processing is incompatible with --fingerprint-mode=%s",
+			config.FingerprintModeChecksumContext)
+	}
+
 	icfg := cfg.Issues
 	excludePatterns := icfg.ExcludePatterns
 	if icfg.UseDefaultExcludes {
@@ -37,11 +50,21 @@ func NewRunner(astCache *astcache.Cache, cfg *config.Config, log logutils.Log, g
 		excludeTotalPattern = fmt.Sprintf("(%s)", strings.Join(excludePatterns, "|"))
 	}
 
+	excludeRulesProcessor, err := newExcludeRulesProcessor(cfg, astCache)
+	if err != nil {
+		return nil, err
+	}
+
 	skipFilesProcessor, err := processors.NewSkipFiles(cfg.Run.SkipFiles)
 	if err != nil {
 		return nil, err
 	}
 
+	ignoreFileProcessor, err := processors.NewIgnoreFile(filepath.Join(filepath.Dir(cfg.Run.Config), processors.IgnoreFileName))
+	if err != nil {
+		return nil, err
+	}
+
 	skipDirs := append([]string{}, packages.StdExcludeDirRegexps...)
 	skipDirs = append(skipDirs, cfg.Run.SkipDirs...)
 	skipDirsProcessor, err := processors.NewSkipDirs(skipDirs, log.Child("skip dirs"), cfg.Run.Args)
@@ -49,29 +72,81 @@ func NewRunner(astCache *astcache.Cache, cfg *config.Config, log logutils.Log, g
 		return nil, err
 	}
 
+	pathModeProcessor, err := processors.NewPathMode(cfg.Output.PathMode)
+	if err != nil {
+		return nil, err
+	}
+
+	lintersOverridesProcessor, err := processors.NewLintersOverrides(cfg.Linters.Overrides, lintersdb.NewManager())
+	if err != nil {
+		return nil, err
+	}
+
+	nolintProcessor, err := processors.NewNolint(astCache, log.Child("nolint"), icfg.NolintMostSpecific, icfg.NolintReport)
+	if err != nil {
+		return nil, err
+	}
+
+	var report *mergedReport
+	if icfg.MergeReport != "" {
+		if report, err = loadMergedReport(icfg.MergeReport); err != nil {
+			return nil, err
+		}
+	}
+
+	procs := []processors.Processor{
+		processors.NewPathPrettifier(), // must be before diff, nolint and exclude autogenerated processor at least
+		processors.NewCgo(goenv),
+		skipFilesProcessor,
+		skipDirsProcessor, // must be after path prettifier
+		ignoreFileProcessor,
+
+		processors.NewAutogeneratedExclude(astCache),
+		processors.NewExclude(excludeTotalPattern),
+		excludeRulesProcessor,
+		lintersOverridesProcessor,
+		nolintProcessor,
+
+		processors.NewMergeSamePosition(icfg.MergeSamePosition), // must be before uniq by line
+		processors.NewUniqByLine(),
+		processors.NewDiff(icfg.Diff, icfg.DiffFromRevision, icfg.DiffPatchFilePath),
+		processors.NewMaxPerFileFromLinter(),
+		processors.NewMaxSameIssues(icfg.MaxSameIssues, log.Child("max_same_issues")),
+		processors.NewMaxFromLinter(icfg.MaxIssuesPerLinter, log.Child("max_from_linter")),
+		processors.NewFixer(icfg.NeedFix, icfg.FixMinConfidence, log.Child("fixer")),
+		processors.NewMessagePrefix(cfg.LintersSettings.MessagePrefixes), // must be after processors matching texts
+		processors.NewTestSeverity(icfg.TestSeverity),
+	}
+	if !cfg.Output.MinimalProcessing {
+		procs = append(procs, processors.NewSourceCode(cfg.Run.MaxOpenFiles, log.Child("source_code")))
+	}
+	procs = append(procs,
+		processors.NewPathShortener(),
+		pathModeProcessor, // must be after all processors reading files
+	)
+
 	return &Runner{
-		Processors: []processors.Processor{
-			processors.NewPathPrettifier(), // must be before diff, nolint and exclude autogenerated processor at least
-			processors.NewCgo(goenv),
-			skipFilesProcessor,
-			skipDirsProcessor, // must be after path prettifier
-
-			processors.NewAutogeneratedExclude(astCache),
-			processors.NewExclude(excludeTotalPattern),
-			processors.NewNolint(astCache, log.Child("nolint")),
-
-			processors.NewUniqByLine(),
-			processors.NewDiff(icfg.Diff, icfg.DiffFromRevision, icfg.DiffPatchFilePath),
-			processors.NewMaxPerFileFromLinter(),
-			processors.NewMaxSameIssues(icfg.MaxSameIssues, log.Child("max_same_issues")),
-			processors.NewMaxFromLinter(icfg.MaxIssuesPerLinter, log.Child("max_from_linter")),
-			processors.NewSourceCode(log.Child("source_code")),
-			processors.NewPathShortener(),
-		},
-		Log: log,
+		Processors:   procs,
+		Log:          log,
+		mergedReport: report,
 	}, nil
 }
 
+func newExcludeRulesProcessor(cfg *config.Config, astCache *astcache.Cache) (*processors.ExcludeRules, error) {
+	icfg := cfg.Issues
+	sets, err := config.LoadExcludeRuleSets(icfg.ExcludeRuleSets, icfg.ExcludeRuleSetsFiles, filepath.Dir(cfg.Run.Config))
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := config.ResolveExcludeRules(icfg.ExcludeRules, sets)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid exclude-rules")
+	}
+
+	return processors.NewExcludeRules(rules, astCache)
+}
+
 type lintRes struct {
 	linter *linter.Config
 	err    error
@@ -159,8 +234,9 @@ func getSortedLintersConfigs(linters []*linter.Config) []*linter.Config {
 	ret := make([]*linter.Config, len(linters))
 	copy(ret, linters)
 
-	sort.Slice(ret, func(i, j int) bool {
-		return ret[i].GetSpeed() < ret[j].GetSpeed()
+	// start long running linters first: other workers run quick linters meanwhile
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].GetCost() > ret[j].GetCost()
 	})
 
 	return ret
@@ -259,9 +335,55 @@ func collectIssues(resCh <-chan lintRes) <-chan result.Issue {
 	return retIssues
 }
 
+// stopOnFirstIssue passes only the first issue after processing and cancels
+// running of remaining linters.
+func (r Runner) stopOnFirstIssue(inCh <-chan lintRes, cancel context.CancelFunc) <-chan lintRes {
+	outCh := make(chan lintRes, 1)
+
+	go func() {
+		defer close(outCh)
+		defer cancel()
+
+		for res := range inCh {
+			if len(res.issues) == 0 {
+				continue
+			}
+
+			r.Log.Infof("Stopping on the first issue found by %s", res.linter.Name())
+			res.issues = res.issues[:1]
+			outCh <- res
+
+			// don't wait for already running linters: their results would be dropped anyway
+			go func() {
+				for range inCh {
+				}
+			}()
+			return
+		}
+	}()
+
+	return outCh
+}
+
 func (r Runner) Run(ctx context.Context, linters []*linter.Config, lintCtx *linter.Context) <-chan result.Issue {
-	lintResultsCh := r.runWorkers(ctx, lintCtx, linters)
+	var cancel context.CancelFunc
+	if lintCtx.Cfg.Run.FailFast {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+
+	if lintCtx.Cfg.Run.BestEffortAST && !hasLinter(linters, golinters.TypeCheck{}.Name()) {
+		// syntax errors must be reported even if AST linters analyzed the valid parts of files
+		linters = append(linters, linter.NewConfig(golinters.TypeCheck{}))
+	}
+
+	lintResultsCh := r.runWorkersWithFixCache(ctx, lintCtx, linters)
+	if r.mergedReport != nil {
+		lintResultsCh = r.mergeReport(lintResultsCh)
+	}
 	processedLintResultsCh := r.processLintResults(lintResultsCh)
+	if cancel != nil {
+		processedLintResultsCh = r.stopOnFirstIssue(processedLintResultsCh, cancel)
+	}
 	if ctx.Err() != nil {
 		// XXX: always process issues, even if timeout occurred
 		finishedLintersN := 0
@@ -276,6 +398,91 @@ func (r Runner) Run(ctx context.Context, linters []*linter.Config, lintCtx *lint
 	return collectIssues(processedLintResultsCh)
 }
 
+// runWorkersWithFixCache runs linters if --fix-cache isn't set or there are no cached issues
+// for the inputs of linters, otherwise with --fix it returns cached issues.
+func (r *Runner) runWorkersWithFixCache(ctx context.Context, lintCtx *linter.Context, linters []*linter.Config) <-chan lintRes {
+	if !lintCtx.Cfg.Issues.FixCache {
+		return r.runWorkers(ctx, lintCtx, linters)
+	}
+
+	cache, err := r.newFixCache(lintCtx, linters)
+	if err != nil {
+		r.Log.Warnf("Can't use fix cache: %s", err)
+		return r.runWorkers(ctx, lintCtx, linters)
+	}
+
+	if lintCtx.Cfg.Issues.NeedFix {
+		if cached, ok := cache.get(); ok {
+			r.Log.Infof("Using cached issues of %d linters from %s", len(linters), cache.path())
+			return cachedLintResults(linters, cached)
+		}
+	}
+
+	return r.storeToFixCache(r.runWorkers(ctx, lintCtx, linters), len(linters), cache)
+}
+
+func (r *Runner) newFixCache(lintCtx *linter.Context, linters []*linter.Config) (*fixCache, error) {
+	dir, err := defaultFixCacheDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return newFixCache(dir, lintCtx, linters, r.Log.Child("fix_cache"))
+}
+
+func cachedLintResults(linters []*linter.Config, cached map[string][]result.Issue) <-chan lintRes {
+	outCh := make(chan lintRes, len(linters))
+	for _, lc := range linters {
+		outCh <- lintRes{
+			linter: lc,
+			issues: cached[lc.Name()],
+		}
+	}
+	close(outCh)
+
+	return outCh
+}
+
+// storeToFixCache passes results of linters and caches their issues if all linters finished successfully.
+func (r *Runner) storeToFixCache(inCh <-chan lintRes, lintersCount int, cache *fixCache) <-chan lintRes {
+	outCh := make(chan lintRes, lintersCount)
+
+	go func() {
+		defer close(outCh)
+
+		issues := map[string][]result.Issue{}
+		failed := false
+		for res := range inCh {
+			if res.err != nil {
+				failed = true
+			} else {
+				// processors change issues, keep a copy of what linters returned
+				issues[res.linter.Name()] = append([]result.Issue{}, res.issues...)
+			}
+			outCh <- res
+		}
+
+		if failed || len(issues) != lintersCount {
+			return // don't cache partial results, e.g. on deadline
+		}
+		if err := cache.put(issues); err != nil {
+			r.Log.Warnf("Can't store issues to fix cache: %s", err)
+		}
+	}()
+
+	return outCh
+}
+
+func hasLinter(linters []*linter.Config, name string) bool {
+	for _, lc := range linters {
+		if lc.Name() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (r *Runner) processIssues(issues []result.Issue, sw *timeutils.Stopwatch) []result.Issue {
 	for _, p := range r.Processors {
 		var newIssues []result.Issue
@@ -0,0 +1,172 @@
+package lint
+
+import (
+	"context"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/goutil"
+	"github.com/golangci/golangci-lint/pkg/lint/astcache"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/golangci/golangci-lint/pkg/result/processors"
+)
+
+type issuingLinter struct{}
+
+func (issuingLinter) Name() string { return "issuing" }
+func (issuingLinter) Desc() string { return "" }
+func (issuingLinter) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	return []result.Issue{
+		{Pos: token.Position{Filename: "a.go", Line: 1}, Text: "first", FromLinter: "issuing"},
+		{Pos: token.Position{Filename: "a.go", Line: 2}, Text: "second", FromLinter: "issuing"},
+	}, nil
+}
+
+type waitingLinter struct {
+	finished chan error
+}
+
+func (waitingLinter) Name() string { return "waiting" }
+func (waitingLinter) Desc() string { return "" }
+func (l waitingLinter) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	var err error
+	select {
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-time.After(10 * time.Second):
+	}
+	l.finished <- err
+
+	return []result.Issue{{Pos: token.Position{Filename: "b.go", Line: 1}, Text: "late", FromLinter: "waiting"}}, err
+}
+
+func TestRunnerFailFast(t *testing.T) {
+	cfg := config.NewDefault()
+	cfg.Run.Concurrency = 2
+	cfg.Run.FailFast = true
+
+	waiting := waitingLinter{finished: make(chan error, 1)}
+	linters := []*linter.Config{
+		linter.NewConfig(issuingLinter{}).WithSpeed(1),
+		linter.NewConfig(waiting).WithSpeed(10),
+	}
+
+	r := Runner{Log: logutils.NewStderrLog("runner")}
+	var issues []result.Issue
+	for i := range r.Run(context.Background(), linters, &linter.Context{Cfg: cfg}) {
+		issues = append(issues, i)
+	}
+
+	if assert.Len(t, issues, 1) {
+		assert.Equal(t, "first", issues[0].Text)
+	}
+	assert.Equal(t, context.Canceled, <-waiting.finished)
+}
+
+type recordingLinter struct {
+	name  string
+	mu    *sync.Mutex
+	order *[]string
+}
+
+func (l recordingLinter) Name() string { return l.name }
+func (recordingLinter) Desc() string   { return "" }
+func (l recordingLinter) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	*l.order = append(*l.order, l.name)
+	return nil, nil
+}
+
+func TestRunnerStartsCostlyLintersFirst(t *testing.T) {
+	cfg := config.NewDefault()
+	cfg.Run.Concurrency = 1
+
+	var mu sync.Mutex
+	var order []string
+	newLinter := func(name string) linter.Linter {
+		return recordingLinter{name: name, mu: &mu, order: &order}
+	}
+	linters := []*linter.Config{
+		linter.NewConfig(newLinter("ast")).WithSpeed(10),
+		linter.NewConfig(newLinter("typed")).WithTypeInfo().WithSpeed(8),
+		linter.NewConfig(newLinter("slow_ast")).WithSpeed(6),
+		linter.NewConfig(newLinter("ssa")).WithSSA().WithSpeed(8),
+	}
+
+	r := Runner{Log: logutils.NewStderrLog("runner")}
+	for range r.runWorkers(context.Background(), &linter.Context{Cfg: cfg}, linters) {
+	}
+
+	assert.Equal(t, []string{"ssa", "typed", "slow_ast", "ast"}, order)
+}
+
+type fileIssuingLinter struct {
+	filename string
+}
+
+func (fileIssuingLinter) Name() string { return "file_issuing" }
+func (fileIssuingLinter) Desc() string { return "" }
+func (l fileIssuingLinter) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	return []result.Issue{{Pos: token.Position{Filename: l.filename, Line: 1}, Text: "issue", FromLinter: "file_issuing"}}, nil
+}
+
+func TestRunnerMinimalProcessing(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "minimal")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	filename := filepath.Join(tmpDir, "a.go")
+	require.NoError(t, ioutil.WriteFile(filename, []byte("package a\n"), os.ModePerm))
+
+	log := logutils.NewStderrLog("runner")
+	astCache := astcache.LoadFromFilenames(log, filename)
+	linters := []*linter.Config{linter.NewConfig(fileIssuingLinter{filename: filename})}
+	run := func(minimal bool) ([]result.Issue, bool) {
+		cfg := config.NewDefault()
+		cfg.Run.Concurrency = 1
+		cfg.Output.MinimalProcessing = minimal
+
+		r, err := NewRunner(astCache, cfg, log, goutil.NewEnv(log))
+		require.NoError(t, err)
+
+		readsSources := false
+		for _, p := range r.Processors {
+			if _, ok := p.(*processors.SourceCode); ok {
+				readsSources = true
+			}
+		}
+
+		var issues []result.Issue
+		for i := range r.Run(context.Background(), linters, &linter.Context{Cfg: cfg}) {
+			issues = append(issues, i)
+		}
+		require.Len(t, issues, 1)
+		return issues, readsSources
+	}
+
+	issues, readsSources := run(false)
+	assert.True(t, readsSources)
+	assert.Equal(t, []string{"package a"}, issues[0].SourceLines)
+
+	issues, readsSources = run(true)
+	assert.False(t, readsSources)
+	assert.Empty(t, issues[0].SourceLines)
+
+	cfg := config.NewDefault()
+	cfg.Output.MinimalProcessing = true
+	cfg.Output.FingerprintMode = config.FingerprintModeChecksumContext
+	_, err = NewRunner(astCache, cfg, log, goutil.NewEnv(log))
+	assert.Error(t, err, "checksum-context fingerprints need source lines of issues")
+}
@@ -0,0 +1,5 @@
+package overlay
+
+type T int
+
+func F() {}
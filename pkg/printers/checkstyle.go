@@ -4,8 +4,9 @@ import (
 	"context"
 	"encoding/xml"
 	"fmt"
+	"io"
+	"strings"
 
-	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
@@ -30,13 +31,20 @@ type checkstyleError struct {
 
 const defaultSeverity = "error"
 
-type Checkstyle struct{}
+type Checkstyle struct {
+	severities map[string]string
+	w          io.Writer
+}
 
-func NewCheckstyle() *Checkstyle {
-	return &Checkstyle{}
+// NewCheckstyle creates the printer mapping severities of issues to checkstyle ones by severities.
+func NewCheckstyle(severities map[string]string, w io.Writer) *Checkstyle {
+	return &Checkstyle{
+		severities: severities,
+		w:          w,
+	}
 }
 
-func (Checkstyle) Print(ctx context.Context, issues <-chan result.Issue) error {
+func (p Checkstyle) Print(ctx context.Context, issues <-chan result.Issue) error {
 	out := checkstyleOutput{
 		Version: "5.0",
 	}
@@ -60,6 +68,11 @@ func (Checkstyle) Print(ctx context.Context, issues <-chan result.Issue) error {
 			Source:   issue.FromLinter,
 			Severity: defaultSeverity,
 		}
+		if severity, ok := p.severities[strings.ToLower(issue.Severity)]; ok {
+			newError.Severity = severity
+		} else if issue.Severity == result.SeverityWarning {
+			newError.Severity = result.SeverityWarning
+		}
 
 		file.Errors = append(file.Errors, newError)
 	}
@@ -74,6 +87,6 @@ func (Checkstyle) Print(ctx context.Context, issues <-chan result.Issue) error {
 		return err
 	}
 
-	fmt.Fprintf(logutils.StdOut, "%s%s\n", xml.Header, data)
+	fmt.Fprintf(p.w, "%s%s\n", xml.Header, data)
 	return nil
 }
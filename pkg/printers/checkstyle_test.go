@@ -0,0 +1,41 @@
+package printers
+
+import (
+	"encoding/xml"
+	"go/token"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestCheckstyleSeverityMapping(t *testing.T) {
+	issues := []result.Issue{
+		{FromLinter: "gosec", Text: "G101: Potential hardcoded credentials", Severity: "critical",
+			Pos: token.Position{Filename: "a.go", Line: 1}},
+		{FromLinter: "gosec", Text: "G104: Errors unhandled", Severity: "low",
+			Pos: token.Position{Filename: "a.go", Line: 2}},
+		{FromLinter: "golint", Text: "exported func F should have comment or be unexported", Severity: result.SeverityWarning,
+			Pos: token.Position{Filename: "a.go", Line: 3}},
+		{FromLinter: "govet", Text: "unreachable code",
+			Pos: token.Position{Filename: "a.go", Line: 4}},
+	}
+
+	out := printToString(t, func(w io.Writer) Printer {
+		return NewCheckstyle(map[string]string{"critical": "error", "low": "info"}, w)
+	}, issues)
+
+	var report checkstyleOutput
+	require.NoError(t, xml.Unmarshal([]byte(strings.TrimPrefix(out, xml.Header)), &report))
+	require.Len(t, report.Files, 1)
+
+	var severities []string
+	for _, e := range report.Files[0].Errors {
+		severities = append(severities, e.Severity)
+	}
+	assert.Equal(t, []string{"error", "info", "warning", "error"}, severities)
+}
@@ -0,0 +1,54 @@
+package printers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// issueIDLen is a length of issues IDs in hex digits: enough to distinguish issues of a project
+const issueIDLen = 8
+
+// IssueID returns the short stable ID of the issue: the prefix of its fingerprint in the mode.
+func IssueID(i *result.Issue, mode string) string {
+	return fingerprint(i, mode)[:issueIDLen]
+}
+
+func validateFingerprintMode(mode string) error {
+	if mode == "" {
+		return nil // line by default
+	}
+
+	for _, m := range config.FingerprintModes {
+		if m == mode {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid fingerprint mode %q, only (%s) allowed", mode, strings.Join(config.FingerprintModes, "|"))
+}
+
+// fingerprint returns the same value for the same issue in different runs; what's the same issue
+// is defined by the mode, see config.FingerprintModes.
+func fingerprint(i *result.Issue, mode string) string {
+	var data string
+	switch mode {
+	case config.FingerprintModeText:
+		data = fmt.Sprintf("%s:%s:%s", i.FromLinter, i.FilePath(), i.Text)
+	case config.FingerprintModeChecksumContext:
+		var context []string
+		for _, line := range i.SourceLines {
+			context = append(context, strings.TrimSpace(line)) // ignore reindentation
+		}
+		data = fmt.Sprintf("%s:%s:%s:%s", i.FromLinter, i.FilePath(), i.Text, strings.Join(context, "\n"))
+	default:
+		data = fmt.Sprintf("%s:%s:%d:%s", i.FromLinter, i.FilePath(), i.Line(), i.Text)
+	}
+
+	hash := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(hash[:])
+}
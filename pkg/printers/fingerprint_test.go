@@ -0,0 +1,74 @@
+package printers
+
+import (
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newFingerprintIssue(line int, sourceLine string) result.Issue {
+	return result.Issue{
+		FromLinter:  "gosec",
+		Text:        "G401: Use of weak cryptographic primitive",
+		Pos:         token.Position{Filename: "pkg/a.go", Line: line, Column: 7},
+		SourceLines: []string{sourceLine},
+	}
+}
+
+func TestFingerprintModes(t *testing.T) {
+	i := newFingerprintIssue(10, "\th := md5.New()")
+	golden := map[string]string{
+		config.FingerprintModeText:            "f8389700d0e4dc76c5802d7e2af3666a896dde834a0d9dfaf69ef52ed9c3896d",
+		config.FingerprintModeLine:            "0101cc87dbf2e6034e76393c953cfebb9902a2fc18375ea517d81238aae1dfb9",
+		config.FingerprintModeChecksumContext: "8f9882e0f2c3a26af666b614a12cf1dec2cdfc6c47b4d6a46fe72ddada93f83c",
+	}
+
+	seen := map[string]string{}
+	for _, mode := range config.FingerprintModes {
+		fp := fingerprint(&i, mode)
+		assert.Equal(t, golden[mode], fp, mode)
+
+		iCopy := i
+		assert.Equal(t, fp, fingerprint(&iCopy, mode), "mode %s isn't deterministic", mode)
+
+		if otherMode, ok := seen[fp]; ok {
+			t.Errorf("modes %s and %s have the same fingerprint", mode, otherMode)
+		}
+		seen[fp] = mode
+	}
+}
+
+func TestFingerprintMovedCode(t *testing.T) {
+	i := newFingerprintIssue(10, "\th := md5.New()")
+	moved := newFingerprintIssue(20, "\t\th := md5.New()")
+	changed := newFingerprintIssue(10, "\th := md5.New() // checksum")
+
+	assert.Equal(t, fingerprint(&i, config.FingerprintModeText), fingerprint(&moved, config.FingerprintModeText))
+	assert.Equal(t, fingerprint(&i, config.FingerprintModeText), fingerprint(&changed, config.FingerprintModeText))
+
+	assert.NotEqual(t, fingerprint(&i, config.FingerprintModeLine), fingerprint(&moved, config.FingerprintModeLine))
+	assert.Equal(t, fingerprint(&i, config.FingerprintModeLine), fingerprint(&changed, config.FingerprintModeLine))
+
+	assert.Equal(t, fingerprint(&i, config.FingerprintModeChecksumContext),
+		fingerprint(&moved, config.FingerprintModeChecksumContext))
+	assert.NotEqual(t, fingerprint(&i, config.FingerprintModeChecksumContext),
+		fingerprint(&changed, config.FingerprintModeChecksumContext))
+}
+
+func TestIssueID(t *testing.T) {
+	i := newFingerprintIssue(10, "\th := md5.New()")
+	other := newFingerprintIssue(10, "\th := md5.New()")
+	other.Text = "G501: Blocklisted import crypto/md5: weak cryptographic primitive"
+
+	id := IssueID(&i, config.FingerprintModeLine)
+	assert.Len(t, id, issueIDLen)
+	assert.Equal(t, fingerprint(&i, config.FingerprintModeLine)[:issueIDLen], id)
+
+	iCopy := i
+	assert.Equal(t, id, IssueID(&iCopy, config.FingerprintModeLine))
+	assert.NotEqual(t, id, IssueID(&other, config.FingerprintModeLine))
+}
@@ -0,0 +1,204 @@
+package printers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// GitLab SAST report schema: https://gitlab.com/gitlab-org/security-products/security-report-schemas
+const gitlabSASTSchemaVersion = "15.0.0"
+
+// gitlabSASTLinters are linters reporting security problems: only their issues get into the report.
+var gitlabSASTLinters = map[string]bool{
+	"gosec": true,
+}
+
+var gitlabSASTRuleIDRe = regexp.MustCompile(`^([A-Z]+\d+): `)
+
+type gitlabSASTReport struct {
+	Version         string                    `json:"version"`
+	Vulnerabilities []gitlabSASTVulnerability `json:"vulnerabilities"`
+	Scan            gitlabSASTScan            `json:"scan"`
+}
+
+type gitlabSASTVulnerability struct {
+	ID          string                 `json:"id"`
+	Category    string                 `json:"category"`
+	Name        string                 `json:"name"`
+	Message     string                 `json:"message"`
+	Description string                 `json:"description"`
+	Severity    string                 `json:"severity"`
+	Scanner     gitlabSASTScanner      `json:"scanner"`
+	Location    gitlabSASTLocation     `json:"location"`
+	Identifiers []gitlabSASTIdentifier `json:"identifiers"`
+}
+
+type gitlabSASTScanner struct {
+	ID      string            `json:"id"`
+	Name    string            `json:"name"`
+	Version string            `json:"version,omitempty"`
+	Vendor  *gitlabSASTVendor `json:"vendor,omitempty"`
+}
+
+type gitlabSASTVendor struct {
+	Name string `json:"name"`
+}
+
+//nolint:tagliatelle // names are defined by the report schema
+type gitlabSASTLocation struct {
+	File      string `json:"file"`
+	StartLine int    `json:"start_line"`
+	EndLine   int    `json:"end_line"`
+}
+
+type gitlabSASTIdentifier struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type gitlabSASTScan struct {
+	Scanner gitlabSASTScanner `json:"scanner"`
+	Type    string            `json:"type"`
+	Status  string            `json:"status"`
+}
+
+var gitlabSASTSeverities = []string{"Info", "Unknown", "Low", "Medium", "High", "Critical"}
+
+type GitLabSAST struct {
+	version         string
+	fingerprintMode string
+	severities      map[string]string
+	w               io.Writer
+}
+
+// NewGitLabSAST creates the printer, severities of issues are mapped to report ones by severities
+// before the default mapping.
+func NewGitLabSAST(version, fingerprintMode string, severities map[string]string, w io.Writer) (*GitLabSAST, error) {
+	if err := validateFingerprintMode(fingerprintMode); err != nil {
+		return nil, err
+	}
+
+	for severity, level := range severities {
+		if !isGitLabSASTSeverity(level) {
+			return nil, fmt.Errorf("invalid gitlab-sast level %q of severity %q: must be one of %s",
+				level, severity, strings.Join(gitlabSASTSeverities, ", "))
+		}
+	}
+
+	return &GitLabSAST{
+		version:         version,
+		fingerprintMode: fingerprintMode,
+		severities:      severities,
+		w:               w,
+	}, nil
+}
+
+func isGitLabSASTSeverity(level string) bool {
+	for _, s := range gitlabSASTSeverities {
+		if s == level {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p GitLabSAST) Print(ctx context.Context, issues <-chan result.Issue) error {
+	report := gitlabSASTReport{
+		Version:         gitlabSASTSchemaVersion,
+		Vulnerabilities: []gitlabSASTVulnerability{},
+		Scan: gitlabSASTScan{
+			Scanner: gitlabSASTScanner{
+				ID:      "golangci-lint",
+				Name:    "golangci-lint",
+				Version: p.version,
+				Vendor:  &gitlabSASTVendor{Name: "GolangCI"},
+			},
+			Type:   "sast",
+			Status: "success",
+		},
+	}
+
+	for i := range issues {
+		if !gitlabSASTLinters[i.FromLinter] {
+			continue
+		}
+
+		report.Vulnerabilities = append(report.Vulnerabilities, p.makeVulnerability(&i))
+	}
+
+	outputJSON, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(p.w, string(outputJSON))
+	return nil
+}
+
+func (p GitLabSAST) makeVulnerability(i *result.Issue) gitlabSASTVulnerability {
+	name := i.FromLinter
+	var identifiers []gitlabSASTIdentifier
+	if m := gitlabSASTRuleIDRe.FindStringSubmatch(i.Text); m != nil {
+		name = m[1]
+		identifiers = append(identifiers, gitlabSASTIdentifier{
+			Type:  i.FromLinter + "_rule_id",
+			Name:  m[1],
+			Value: m[1],
+		})
+	} else {
+		identifiers = append(identifiers, gitlabSASTIdentifier{
+			Type:  "golangci_lint_linter",
+			Name:  i.FromLinter,
+			Value: i.FromLinter,
+		})
+	}
+
+	endLine := i.Line()
+	if i.LineRange != nil && i.LineRange.To > endLine {
+		endLine = i.LineRange.To
+	}
+
+	return gitlabSASTVulnerability{
+		ID:          fingerprint(i, p.fingerprintMode),
+		Category:    "sast",
+		Name:        name,
+		Message:     i.Text,
+		Description: i.Text,
+		Severity:    p.severity(i.Severity),
+		Scanner: gitlabSASTScanner{
+			ID:   "golangci-lint",
+			Name: "golangci-lint",
+		},
+		Location: gitlabSASTLocation{
+			File:      i.FilePath(),
+			StartLine: i.Line(),
+			EndLine:   endLine,
+		},
+		Identifiers: identifiers,
+	}
+}
+
+func (p GitLabSAST) severity(severity string) string {
+	if level, ok := p.severities[strings.ToLower(severity)]; ok {
+		return level
+	}
+
+	switch strings.ToLower(severity) {
+	case "low":
+		return "Low"
+	case "medium":
+		return "Medium"
+	case "high":
+		return "High"
+	}
+
+	return "Unknown"
+}
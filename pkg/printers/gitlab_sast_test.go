@@ -0,0 +1,86 @@
+package printers
+
+import (
+	"encoding/json"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestGitLabSAST(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "gosec",
+			Text:       "G401: Use of weak cryptographic primitive",
+			Severity:   "medium",
+			Pos: token.Position{
+				Filename: "pkg/a.go",
+				Line:     10,
+				Column:   7,
+			},
+		},
+		{
+			FromLinter: "golint", // not a security linter
+			Text:       "exported func F should have comment or be unexported",
+			Pos: token.Position{
+				Filename: "pkg/a.go",
+				Line:     12,
+			},
+		},
+	}
+
+	out := printToString(t, func(w io.Writer) Printer {
+		p, err := NewGitLabSAST("1.2.3", config.FingerprintModeLine, nil, w)
+		require.NoError(t, err)
+		return p
+	}, issues)
+	assertGolden(t, "gitlab_sast.golden", out)
+
+	var report map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.Contains(t, report, "version")
+	assert.Equal(t, "golangci-lint", report["scan"].(map[string]interface{})["scanner"].(map[string]interface{})["id"])
+
+	vulnerabilities := report["vulnerabilities"].([]interface{})
+	require.Len(t, vulnerabilities, 1)
+
+	v := vulnerabilities[0].(map[string]interface{})
+	for _, field := range []string{"id", "category", "message", "severity", "scanner", "location", "identifiers"} {
+		assert.Contains(t, v, field)
+	}
+	assert.Equal(t, "Medium", v["severity"])
+
+	location := v["location"].(map[string]interface{})
+	assert.Equal(t, "pkg/a.go", location["file"])
+	assert.Equal(t, float64(10), location["start_line"])
+}
+
+func TestGitLabSASTInvalidFingerprintMode(t *testing.T) {
+	_, err := NewGitLabSAST("1.2.3", "random", nil, ioutil.Discard)
+	assert.Error(t, err)
+}
+
+func TestGitLabSASTSeverityMapping(t *testing.T) {
+	issues := []result.Issue{{FromLinter: "gosec", Text: "G101: Potential hardcoded credentials", Severity: "critical"}}
+
+	out := printToString(t, func(w io.Writer) Printer {
+		p, err := NewGitLabSAST("1.2.3", config.FingerprintModeLine, map[string]string{"critical": "Critical"}, w)
+		require.NoError(t, err)
+		return p
+	}, issues)
+
+	var report gitlabSASTReport
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	require.Len(t, report.Vulnerabilities, 1)
+	assert.Equal(t, "Critical", report.Vulnerabilities[0].Severity)
+
+	_, err := NewGitLabSAST("1.2.3", config.FingerprintModeLine, map[string]string{"critical": "fatal"}, ioutil.Discard)
+	assert.Error(t, err)
+}
@@ -4,19 +4,21 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 
-	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/report"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
 type JSON struct {
 	rd *report.Data
+	w  io.Writer
 }
 
-func NewJSON(rd *report.Data) *JSON {
+func NewJSON(rd *report.Data, w io.Writer) *JSON {
 	return &JSON{
 		rd: rd,
+		w:  w,
 	}
 }
 
@@ -41,6 +43,6 @@ func (p JSON) Print(ctx context.Context, issues <-chan result.Issue) error {
 		return err
 	}
 
-	fmt.Fprint(logutils.StdOut, string(outputJSON))
+	fmt.Fprint(p.w, string(outputJSON))
 	return nil
 }
@@ -0,0 +1,40 @@
+package printers
+
+import (
+	"context"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// Multi prints issues processed once by all printers: every printer writes the same issues
+// and so the same fingerprints to its own destination.
+type Multi struct {
+	printers []Printer
+}
+
+func NewMulti(printers ...Printer) *Multi {
+	return &Multi{
+		printers: printers,
+	}
+}
+
+func (p Multi) Print(ctx context.Context, issues <-chan result.Issue) error {
+	var allIssues []result.Issue
+	for i := range issues {
+		allIssues = append(allIssues, i)
+	}
+
+	for _, printer := range p.printers {
+		issuesCh := make(chan result.Issue, len(allIssues))
+		for _, i := range allIssues {
+			issuesCh <- i
+		}
+		close(issuesCh)
+
+		if err := printer.Print(ctx, issuesCh); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package printers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestMultiPrintsSameIssues(t *testing.T) {
+	issues := []result.Issue{
+		{FromLinter: "gosec", Text: "G101: Potential hardcoded credentials", Severity: "high",
+			Pos: token.Position{Filename: "a.go", Line: 3, Column: 2}},
+		{FromLinter: "gosec", Text: "G104: Errors unhandled", Severity: "low",
+			Pos: token.Position{Filename: "b.go", Line: 7}},
+	}
+
+	var sastOut, jsonOut bytes.Buffer
+	sast, err := NewGitLabSAST("1.2.3", config.FingerprintModeLine, nil, &sastOut)
+	require.NoError(t, err)
+	p := NewMulti(sast, NewJSON(&report.Data{}, &jsonOut))
+
+	issuesCh := make(chan result.Issue, len(issues))
+	for _, i := range issues {
+		issuesCh <- i
+	}
+	close(issuesCh)
+	require.NoError(t, p.Print(context.Background(), issuesCh))
+
+	var sastReport gitlabSASTReport
+	require.NoError(t, json.Unmarshal(sastOut.Bytes(), &sastReport))
+	var jsonReport JSONResult
+	require.NoError(t, json.Unmarshal(jsonOut.Bytes(), &jsonReport))
+
+	require.Len(t, jsonReport.Issues, len(issues))
+	require.Len(t, sastReport.Vulnerabilities, len(issues))
+	for idx := range issues {
+		assert.Equal(t, fingerprint(&jsonReport.Issues[idx], config.FingerprintModeLine), sastReport.Vulnerabilities[idx].ID)
+	}
+}
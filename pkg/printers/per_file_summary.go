@@ -0,0 +1,91 @@
+package printers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// PerFileSummary prints counts of issues per file with a breakdown by linters
+// instead of issues to triage large reports.
+type PerFileSummary struct {
+	log logutils.Log
+	w   io.Writer
+}
+
+func NewPerFileSummary(log logutils.Log, w io.Writer) *PerFileSummary {
+	return &PerFileSummary{log: log, w: w}
+}
+
+type fileSummary struct {
+	path         string
+	total        int
+	linterCounts map[string]int
+}
+
+func (p PerFileSummary) Print(ctx context.Context, issues <-chan result.Issue) error {
+	summaries := map[string]*fileSummary{}
+	for i := range issues {
+		s := summaries[i.FilePath()]
+		if s == nil {
+			s = &fileSummary{path: i.FilePath(), linterCounts: map[string]int{}}
+			summaries[i.FilePath()] = s
+		}
+		s.total++
+		s.linterCounts[i.FromLinter]++
+	}
+
+	if len(summaries) == 0 {
+		return nil
+	}
+
+	var sorted []*fileSummary
+	for _, s := range summaries {
+		sorted = append(sorted, s)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].total != sorted[j].total {
+			return sorted[i].total > sorted[j].total
+		}
+		return sorted[i].path < sorted[j].path
+	})
+
+	w := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "FILE\tISSUES\tLINTERS")
+	for _, s := range sorted {
+		fmt.Fprintf(w, "%s\t%d\t%s\n", s.path, s.total, formatLinterCounts(s.linterCounts))
+	}
+
+	if err := w.Flush(); err != nil {
+		p.log.Warnf("Can't flush tab writer: %s", err)
+	}
+
+	return nil
+}
+
+// formatLinterCounts formats counts like "lll: 2, golint: 1" ordered by count descending.
+func formatLinterCounts(counts map[string]int) string {
+	var linters []string
+	for name := range counts {
+		linters = append(linters, name)
+	}
+	sort.Slice(linters, func(i, j int) bool {
+		if counts[linters[i]] != counts[linters[j]] {
+			return counts[linters[i]] > counts[linters[j]]
+		}
+		return linters[i] < linters[j]
+	})
+
+	parts := make([]string, 0, len(linters))
+	for _, name := range linters {
+		parts = append(parts, fmt.Sprintf("%s: %d", name, counts[name]))
+	}
+
+	return strings.Join(parts, ", ")
+}
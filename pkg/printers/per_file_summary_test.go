@@ -0,0 +1,37 @@
+package printers
+
+import (
+	"go/token"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestPerFileSummary(t *testing.T) {
+	newIssue := func(file, linter string) result.Issue {
+		return result.Issue{
+			FromLinter: linter,
+			Text:       "text",
+			Pos:        token.Position{Filename: file, Line: 1},
+		}
+	}
+	issues := []result.Issue{
+		newIssue("a.go", "errcheck"),
+		newIssue("b.go", "lll"),
+		newIssue("b.go", "golint"),
+		newIssue("b.go", "lll"),
+		newIssue("c.go", "govet"),
+	}
+
+	out := printToString(t, func(w io.Writer) Printer {
+		return NewPerFileSummary(logutils.NewStderrLog(""), w)
+	}, issues)
+	assert.Equal(t, "FILE  ISSUES  LINTERS\n"+
+		"b.go  3       lll: 2, golint: 1\n"+
+		"a.go  1       errcheck: 1\n"+
+		"c.go  1       govet: 1\n", out)
+}
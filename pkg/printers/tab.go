@@ -13,14 +13,16 @@ import (
 )
 
 type Tab struct {
-	printLinterName bool
-	log             logutils.Log
+	opts TextOptions
+	log  logutils.Log
+	w    io.Writer
 }
 
-func NewTab(printLinterName bool, log logutils.Log) *Tab {
+func NewTab(opts TextOptions, log logutils.Log, w io.Writer) *Tab {
 	return &Tab{
-		printLinterName: printLinterName,
-		log:             log,
+		opts: opts,
+		log:  log,
+		w:    w,
 	}
 }
 
@@ -30,7 +32,7 @@ func (p Tab) SprintfColored(ca color.Attribute, format string, args ...interface
 }
 
 func (p *Tab) Print(ctx context.Context, issues <-chan result.Issue) error {
-	w := tabwriter.NewWriter(logutils.StdOut, 0, 0, 2, ' ', 0)
+	w := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
 
 	for i := range issues {
 		i := i
@@ -45,10 +47,13 @@ func (p *Tab) Print(ctx context.Context, issues <-chan result.Issue) error {
 }
 
 func (p Tab) printIssue(i *result.Issue, w io.Writer) {
-	text := p.SprintfColored(color.FgRed, "%s", i.Text)
-	if p.printLinterName {
+	text := p.SprintfColored(color.FgRed, "%s", truncateText(i.Text, p.opts.MaxTextLen))
+	if p.opts.PrintLinterName {
 		text = fmt.Sprintf("%s\t%s", i.FromLinter, text)
 	}
+	if p.opts.PrintIssueID {
+		text = fmt.Sprintf("%s\t%s", i.ID, text)
+	}
 
 	pos := p.SprintfColored(color.Bold, "%s:%d", i.FilePath(), i.Line())
 	if i.Pos.Column != 0 {
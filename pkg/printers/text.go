@@ -3,6 +3,8 @@ package printers
 import (
 	"context"
 	"fmt"
+	"io"
+	"unicode/utf8"
 
 	"github.com/fatih/color"
 
@@ -10,25 +12,34 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-type Text struct {
-	printIssuedLine bool
-	useColors       bool
-	printLinterName bool
+// TextOptions configure text and tab printers: the tab printer uses only PrintLinterName,
+// PrintIssueID and MaxTextLen.
+type TextOptions struct {
+	PrintIssuedLine bool
+	UseColors       bool
+	PrintLinterName bool
+	CollapseRepeats bool
+	GroupFixable    bool
+	PrintIssueID    bool
+	MaxTextLen      int // 0 means no limit
+}
 
-	log logutils.Log
+type Text struct {
+	opts TextOptions
+	log  logutils.Log
+	w    io.Writer
 }
 
-func NewText(printIssuedLine, useColors, printLinterName bool, log logutils.Log) *Text {
+func NewText(opts TextOptions, log logutils.Log, w io.Writer) *Text {
 	return &Text{
-		printIssuedLine: printIssuedLine,
-		useColors:       useColors,
-		printLinterName: printLinterName,
-		log:             log,
+		opts: opts,
+		log:  log,
+		w:    w,
 	}
 }
 
 func (p Text) SprintfColored(ca color.Attribute, format string, args ...interface{}) string {
-	if !p.useColors {
+	if !p.opts.UseColors {
 		return fmt.Sprintf(format, args...)
 	}
 
@@ -37,36 +48,132 @@ func (p Text) SprintfColored(ca color.Attribute, format string, args ...interfac
 }
 
 func (p *Text) Print(ctx context.Context, issues <-chan result.Issue) error {
+	if p.opts.GroupFixable {
+		p.printGroupedByFixable(issues)
+		return nil
+	}
+
+	add, flush := p.newRepeatsCollapser()
 	for i := range issues {
-		i := i
-		p.printIssue(&i)
+		add(i)
+	}
+	flush()
 
-		if !p.printIssuedLine {
-			continue
+	return nil
+}
+
+// printGroupedByFixable prints issues with suggested fixes and then other issues:
+// it has to wait for all issues.
+func (p Text) printGroupedByFixable(issues <-chan result.Issue) {
+	var fixable, notFixable []result.Issue
+	for i := range issues {
+		if len(i.SuggestedFixes) != 0 {
+			fixable = append(fixable, i)
+		} else {
+			notFixable = append(notFixable, i)
 		}
+	}
 
-		p.printSourceCode(&i)
-		p.printUnderLinePointer(&i)
+	p.printSection("Fixable issues", fixable)
+	if len(fixable) != 0 && len(notFixable) != 0 {
+		fmt.Fprintln(p.w)
 	}
+	p.printSection("Not fixable issues", notFixable)
 
-	return nil
+	if total := len(fixable) + len(notFixable); total != 0 {
+		fmt.Fprintf(p.w, "\n%d of %d issues can be fixed by --fix\n", len(fixable), total)
+	}
 }
 
-func (p Text) printIssue(i *result.Issue) {
-	text := p.SprintfColored(color.FgRed, "%s", i.Text)
-	if p.printLinterName {
+func (p Text) printSection(title string, issues []result.Issue) {
+	if len(issues) == 0 {
+		return
+	}
+
+	fmt.Fprintln(p.w, p.SprintfColored(color.Bold, "%s (%d):", title, len(issues)))
+	add, flush := p.newRepeatsCollapser()
+	for _, i := range issues {
+		add(i)
+	}
+	flush()
+}
+
+// newRepeatsCollapser returns functions to print issues one by one: with collapsing of
+// repeats an issue is printed only after the next different one was added or after flush.
+func (p Text) newRepeatsCollapser() (add func(i result.Issue), flush func()) {
+	var repeats []result.Issue
+	flush = func() {
+		if len(repeats) != 0 {
+			p.printIssues(repeats)
+			repeats = nil
+		}
+	}
+	add = func(i result.Issue) {
+		if !p.opts.CollapseRepeats || len(repeats) != 0 && !isRepeatedIssue(&repeats[len(repeats)-1], &i) {
+			flush()
+		}
+		repeats = append(repeats, i)
+	}
+
+	return add, flush
+}
+
+// isRepeatedIssue checks whether the issue repeats the previous one on the next line.
+func isRepeatedIssue(prev, i *result.Issue) bool {
+	return i.FromLinter == prev.FromLinter && i.Text == prev.Text &&
+		i.FilePath() == prev.FilePath() && i.Line() == prev.Line()+1
+}
+
+// printIssues prints the issue or the issue repeated on consecutive lines once.
+func (p Text) printIssues(repeats []result.Issue) {
+	i := &repeats[0]
+	p.printIssue(i, repeats[len(repeats)-1].Line(), len(repeats))
+
+	if !p.opts.PrintIssuedLine {
+		return
+	}
+
+	p.printSourceCode(i)
+	p.printUnderLinePointer(i)
+}
+
+func (p Text) printIssue(i *result.Issue, lastLine, count int) {
+	text := p.SprintfColored(color.FgRed, "%s", truncateText(i.Text, p.opts.MaxTextLen))
+	if p.opts.PrintLinterName {
 		text += fmt.Sprintf(" (%s)", i.FromLinter)
 	}
-	pos := p.SprintfColored(color.Bold, "%s:%d", i.FilePath(), i.Line())
-	if i.Pos.Column != 0 {
-		pos += fmt.Sprintf(":%d", i.Pos.Column)
+	if p.opts.PrintIssueID && i.ID != "" {
+		text += fmt.Sprintf(" [%s]", i.ID)
+	}
+	if count > 1 {
+		text += fmt.Sprintf(" (x%d)", count)
+	}
+
+	var pos string
+	if lastLine != i.Line() {
+		pos = p.SprintfColored(color.Bold, "%s:%d-%d", i.FilePath(), i.Line(), lastLine)
+	} else {
+		pos = p.SprintfColored(color.Bold, "%s:%d", i.FilePath(), i.Line())
+		if i.Pos.Column != 0 {
+			pos += fmt.Sprintf(":%d", i.Pos.Column)
+		}
+	}
+	fmt.Fprintf(p.w, "%s: %s\n", pos, text)
+}
+
+// truncateText cuts the text to maxLen runes ending with an ellipsis, 0 means no limit.
+func truncateText(text string, maxLen int) string {
+	if maxLen <= 0 || utf8.RuneCountInString(text) <= maxLen {
+		return text
 	}
-	fmt.Fprintf(logutils.StdOut, "%s: %s\n", pos, text)
+
+	runes := []rune(text)
+	return string(runes[:maxLen-1]) + "…"
 }
 
 func (p Text) printSourceCode(i *result.Issue) {
 	for _, line := range i.SourceLines {
-		fmt.Fprintln(logutils.StdOut, line)
+		fmt.Fprintln(p.w, line)
 	}
 }
 
@@ -87,5 +194,5 @@ func (p Text) printUnderLinePointer(i *result.Issue) {
 		}
 	}
 
-	fmt.Fprintf(logutils.StdOut, "%s%s\n", string(prefixRunes), p.SprintfColored(color.FgYellow, "^"))
+	fmt.Fprintf(p.w, "%s%s\n", string(prefixRunes), p.SprintfColored(color.FgYellow, "^"))
 }
@@ -0,0 +1,138 @@
+package printers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func makeTextTestIssues() []result.Issue {
+	newIssue := func(linter, text string, line int) result.Issue {
+		return result.Issue{
+			FromLinter: linter,
+			Text:       text,
+			Pos: token.Position{
+				Filename: "a.go",
+				Line:     line,
+				Column:   2,
+			},
+			SourceLines: []string{"\tx := 1"},
+		}
+	}
+
+	return []result.Issue{
+		newIssue("lll", "line is 130 characters", 10),
+		newIssue("lll", "line is 130 characters", 11),
+		newIssue("lll", "line is 130 characters", 12),
+		newIssue("lll", "line is 130 characters", 14),    // not consecutive line
+		newIssue("golint", "line is 130 characters", 15), // another linter
+		newIssue("golint", "exported func should have comment", 16),
+	}
+}
+
+// printToString prints the issues by the printer created to write to a buffer.
+func printToString(t *testing.T, newPrinter func(w io.Writer) Printer, issues []result.Issue) string {
+	var buf bytes.Buffer
+	p := newPrinter(&buf)
+
+	issuesCh := make(chan result.Issue, len(issues))
+	for _, i := range issues {
+		issuesCh <- i
+	}
+	close(issuesCh)
+
+	require.NoError(t, p.Print(context.Background(), issuesCh))
+	return buf.String()
+}
+
+func assertGolden(t *testing.T, goldenFile, actual string) {
+	expected, err := ioutil.ReadFile(filepath.Join("testdata", goldenFile))
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), actual)
+}
+
+func TestTextCollapseRepeats(t *testing.T) {
+	issues := makeTextTestIssues()
+	log := logutils.NewStderrLog("")
+
+	expanded := printToString(t, func(w io.Writer) Printer {
+		return NewText(TextOptions{PrintIssuedLine: true, PrintLinterName: true}, log, w)
+	}, issues)
+	assertGolden(t, "text_expanded.golden", expanded)
+
+	collapsed := printToString(t, func(w io.Writer) Printer {
+		return NewText(TextOptions{PrintIssuedLine: true, PrintLinterName: true, CollapseRepeats: true}, log, w)
+	}, issues)
+	assertGolden(t, "text_collapsed.golden", collapsed)
+}
+
+func TestTextGroupFixable(t *testing.T) {
+	issues := makeTextTestIssues()
+	for _, idx := range []int{1, 5} {
+		issues[idx].SuggestedFixes = []result.SuggestedFix{{Message: "fix"}}
+	}
+
+	out := printToString(t, func(w io.Writer) Printer {
+		return NewText(TextOptions{PrintLinterName: true, GroupFixable: true}, logutils.NewStderrLog(""), w)
+	}, issues)
+	assertGolden(t, "text_group_fixable.golden", out)
+}
+
+func TestTextMaxIssueTextLen(t *testing.T) {
+	longText := "вызов функции с очень длинным сообщением staticcheck"
+	issues := []result.Issue{{
+		FromLinter: "staticcheck",
+		Text:       longText,
+		Pos:        token.Position{Filename: "a.go", Line: 1},
+	}}
+
+	text := printToString(t, func(w io.Writer) Printer {
+		return NewText(TextOptions{MaxTextLen: 12}, logutils.NewStderrLog(""), w)
+	}, issues)
+	assert.Equal(t, "a.go:1: вызов функц…\n", text)
+
+	tab := printToString(t, func(w io.Writer) Printer {
+		return NewTab(TextOptions{MaxTextLen: 12}, logutils.NewStderrLog(""), w)
+	}, issues)
+	assert.Contains(t, tab, "вызов функц…")
+	assert.NotContains(t, tab, longText)
+
+	out := printToString(t, func(w io.Writer) Printer {
+		return NewJSON(&report.Data{}, w)
+	}, issues)
+	var res JSONResult
+	require.NoError(t, json.Unmarshal([]byte(out), &res))
+	require.Len(t, res.Issues, 1)
+	assert.Equal(t, longText, res.Issues[0].Text)
+}
+
+func TestTextPrintIssueID(t *testing.T) {
+	issues := []result.Issue{{
+		FromLinter: "lll",
+		Text:       "line is 141 characters",
+		ID:         "0101cc87",
+		Pos:        token.Position{Filename: "a.go", Line: 1},
+	}}
+
+	text := printToString(t, func(w io.Writer) Printer {
+		return NewText(TextOptions{PrintLinterName: true, PrintIssueID: true}, logutils.NewStderrLog(""), w)
+	}, issues)
+	assert.Equal(t, "a.go:1: line is 141 characters (lll) [0101cc87]\n", text)
+
+	tab := printToString(t, func(w io.Writer) Printer {
+		return NewTab(TextOptions{PrintLinterName: true, PrintIssueID: true}, logutils.NewStderrLog(""), w)
+	}, issues)
+	assert.Contains(t, tab, "0101cc87")
+}
@@ -0,0 +1,58 @@
+package report
+
+import (
+	"sort"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type scoreKey struct {
+	linter, severity string
+}
+
+// DebtScore is the lint debt score: the weighted sum of counts of issues by linters and severities.
+type DebtScore struct {
+	weights config.ScoreWeights
+	counts  map[scoreKey]int
+}
+
+func NewDebtScore(weights config.ScoreWeights) *DebtScore {
+	return &DebtScore{
+		weights: weights,
+		counts:  map[scoreKey]int{},
+	}
+}
+
+func (s *DebtScore) Add(i *result.Issue) {
+	s.counts[scoreKey{linter: i.FromLinter, severity: i.Severity}]++
+}
+
+// Value sums the counts in the sorted order of keys: the score doesn't depend on the order of issues.
+func (s *DebtScore) Value() float64 {
+	keys := make([]scoreKey, 0, len(s.counts))
+	for k := range s.counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].linter != keys[j].linter {
+			return keys[i].linter < keys[j].linter
+		}
+		return keys[i].severity < keys[j].severity
+	})
+
+	var res float64
+	for _, k := range keys {
+		res += float64(s.counts[k]) * scoreWeight(s.weights.Linters, k.linter) * scoreWeight(s.weights.Severities, k.severity)
+	}
+
+	return res
+}
+
+func scoreWeight(weights map[string]float64, name string) float64 {
+	if w, ok := weights[name]; ok {
+		return w
+	}
+
+	return 1
+}
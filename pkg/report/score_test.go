@@ -0,0 +1,47 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestDebtScore(t *testing.T) {
+	issues := []result.Issue{
+		{FromLinter: "gosec", Severity: "high"},
+		{FromLinter: "gosec", Severity: "high"},
+		{FromLinter: "gosec", Severity: "low"},
+		{FromLinter: "lll", Severity: result.SeverityWarning},
+		{FromLinter: "lll"},
+		{FromLinter: "errcheck"},
+	}
+	weights := config.ScoreWeights{
+		Severities: map[string]float64{"high": 3, result.SeverityWarning: 0.5},
+		Linters:    map[string]float64{"gosec": 2, "lll": 0.25},
+	}
+
+	// gosec: 2*2*3 + 2*1, lll: 0.25*0.5 + 0.25*1, errcheck: 1*1
+	const expected = 12 + 2 + 0.125 + 0.25 + 1
+
+	s := newTestDebtScore(weights, issues)
+	assert.Equal(t, expected, s.Value())
+
+	reversed := make([]result.Issue, 0, len(issues))
+	for i := len(issues) - 1; i >= 0; i-- {
+		reversed = append(reversed, issues[i])
+	}
+	assert.Equal(t, s.Value(), newTestDebtScore(weights, reversed).Value())
+
+	assert.Equal(t, float64(len(issues)), newTestDebtScore(config.ScoreWeights{}, issues).Value())
+}
+
+func newTestDebtScore(weights config.ScoreWeights, issues []result.Issue) *DebtScore {
+	s := NewDebtScore(weights)
+	for i := range issues {
+		s.Add(&issues[i])
+	}
+	return s
+}
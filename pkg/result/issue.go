@@ -6,15 +6,43 @@ type Range struct {
 	From, To int
 }
 
+// TextEdit replaces bytes [Pos, End) of the issue's file with NewText.
+type TextEdit struct {
+	Pos     int
+	End     int
+	NewText string
+}
+
+type SuggestedFix struct {
+	Message   string
+	TextEdits []TextEdit
+
+	// Confidence is a value in (0,1] estimating how safe it's to apply the fix
+	// without review: formatting fixes have 1, refactorings have less.
+	Confidence float64
+}
+
+// SeverityWarning is a severity of issues which don't affect the exit code.
+const SeverityWarning = "warning"
+
 type Issue struct {
 	FromLinter string
 	Text       string
 
+	// Severity is set by linters grading their issues, e.g. gosec: low, medium or high,
+	// and by --test-severity for issues in test files
+	Severity string `json:",omitempty"`
+
+	// ID is a short fingerprint of the issue: it's the same in different runs until the issue is fixed
+	ID string `json:",omitempty"`
+
 	Pos       token.Position
 	LineRange *Range `json:",omitempty"`
 	HunkPos   int    `json:",omitempty"`
 
 	SourceLines []string
+
+	SuggestedFixes []SuggestedFix `json:",omitempty"`
 }
 
 func (i *Issue) FilePath() string {
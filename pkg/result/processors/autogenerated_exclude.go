@@ -46,6 +46,9 @@ func (p *AutogeneratedExclude) shouldPassIssue(i *result.Issue) (bool, error) {
 		// don't hide typechecking errors in generated files: users expect to see why the project isn't compiling
 		return true, nil
 	}
+	if !isGoFile(i) {
+		return true, nil // e.g. issues of non-Go analyzers merged by merge-report
+	}
 
 	fs, err := p.getOrCreateFileSummary(i)
 	if err != nil {
@@ -1,24 +1,40 @@
 package processors
 
 import (
+	"bufio"
+	"fmt"
+	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
 
+	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/goutil"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
+// lineDirective is a //line directive of a file generated by cgo: lines after it are from the source file
+type lineDirective struct {
+	line       int // line of the directive in the generated file
+	filename   string
+	sourceLine int
+	sourceCol  int // 0 if the directive has no column
+}
+
 type Cgo struct {
 	goCacheDir string
+
+	lineDirectives map[string][]lineDirective // of generated files by path
 }
 
 var _ Processor = Cgo{}
 
 func NewCgo(goenv *goutil.Env) *Cgo {
 	return &Cgo{
-		goCacheDir: goenv.Get("GOCACHE"),
+		goCacheDir:     goenv.Get("GOCACHE"),
+		lineDirectives: map[string][]lineDirective{},
 	}
 }
 
@@ -27,32 +43,154 @@ func (p Cgo) Name() string {
 }
 
 func (p Cgo) Process(issues []result.Issue) ([]result.Issue, error) {
-	return filterIssuesErr(issues, func(i *result.Issue) (bool, error) {
+	// issues of the same linter in source files: issues of generated files mapped to them are duplicates
+	sourceIssues := map[string]bool{}
+	for i := range issues {
+		isGenerated, err := p.isGenerated(&issues[i])
+		if err != nil {
+			return nil, err
+		}
+		if !isGenerated {
+			sourceIssues[cgoIssueKey(&issues[i])] = true
+		}
+	}
+
+	return transformIssuesErr(issues, func(i *result.Issue) (*result.Issue, error) {
+		if filepath.Base(i.FilePath()) == "_cgo_gotypes.go" {
+			// skip cgo warning for go1.10
+			return nil, nil //nolint:nilnil // nil issues are dropped
+		}
+
 		// some linters (.e.g gosec, deadcode) return incorrect filepaths for cgo issues,
 		// also cgo files have strange issues looking like false positives.
 
 		// cache dir contains all preprocessed files including cgo files
+		isGenerated, err := p.isGenerated(i)
+		if err != nil || !isGenerated {
+			return i, err
+		}
 
-		issueFilePath := i.FilePath()
-		if !filepath.IsAbs(i.FilePath()) {
-			absPath, err := filepath.Abs(i.FilePath())
-			if err != nil {
-				return false, errors.Wrapf(err, "failed to build abs path for %q", i.FilePath())
-			}
-			issueFilePath = absPath
+		// generated code without //line directive has no source: it's dropped
+		mapped := p.mapToSource(i)
+		if mapped == nil || sourceIssues[cgoIssueKey(mapped)] {
+			return nil, nil //nolint:nilnil
 		}
+		sourceIssues[cgoIssueKey(mapped)] = true
 
-		if p.goCacheDir != "" && strings.HasPrefix(issueFilePath, p.goCacheDir) {
-			return false, nil
+		return mapped, nil
+	})
+}
+
+func (p Cgo) isGenerated(i *result.Issue) (bool, error) {
+	if p.goCacheDir == "" {
+		return false, nil
+	}
+
+	issueFilePath := i.FilePath()
+	if !filepath.IsAbs(i.FilePath()) {
+		absPath, err := filepath.Abs(i.FilePath())
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to build abs path for %q", i.FilePath())
 		}
+		issueFilePath = absPath
+	}
 
-		if filepath.Base(i.FilePath()) == "_cgo_gotypes.go" {
-			// skip cgo warning for go1.10
-			return false, nil
+	return strings.HasPrefix(issueFilePath, p.goCacheDir), nil
+}
+
+func cgoIssueKey(i *result.Issue) string {
+	return fmt.Sprintf("%s\x00%s\x00%s\x00%d", i.FromLinter, i.Text, filepath.Clean(i.FilePath()), i.Line())
+}
+
+// mapToSource returns the issue of the generated file at the position of the //line directive
+// source, nil if there is no directive before the issue.
+func (p Cgo) mapToSource(i *result.Issue) *result.Issue {
+	directives, ok := p.lineDirectives[i.FilePath()]
+	if !ok {
+		directives = readLineDirectives(i.FilePath())
+		p.lineDirectives[i.FilePath()] = directives
+	}
+
+	var d *lineDirective
+	for idx := range directives {
+		if directives[idx].line >= i.Line() {
+			break
 		}
+		d = &directives[idx]
+	}
+	if d == nil {
+		return nil
+	}
 
-		return true, nil
-	})
+	newI := *i
+	newI.Pos.Filename = d.filename
+	if rel, err := fsutils.ShortestRelPath(d.filename, ""); err == nil {
+		newI.Pos.Filename = rel
+	}
+	delta := d.sourceLine - d.line - 1
+	newI.Pos.Line += delta
+	newI.Pos.Offset = 0
+	if d.sourceCol != 0 && i.Line() == d.line+1 && i.Column() != 0 {
+		newI.Pos.Column += d.sourceCol - 1
+	}
+	if i.LineRange != nil {
+		newI.LineRange = &result.Range{From: i.LineRange.From + delta, To: i.LineRange.To + delta}
+	}
+	newI.SuggestedFixes = nil // offsets of edits are in the generated file
+
+	return &newI
+}
+
+// readLineDirectives returns //line directives of the file like //line /path/to/file.go:12:3,
+// nil if the file can't be read.
+func readLineDirectives(path string) []lineDirective {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var directives []lineDirective
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if !strings.HasPrefix(text, "//line ") {
+			continue
+		}
+
+		if d, ok := parseLineDirective(strings.TrimPrefix(text, "//line ")); ok {
+			d.line = line
+			directives = append(directives, d)
+		}
+	}
+
+	return directives
+}
+
+// parseLineDirective parses filename:line or filename:line:col: the filename can contain colons.
+func parseLineDirective(s string) (lineDirective, bool) {
+	var numbers []int
+	for len(numbers) < 2 {
+		idx := strings.LastIndex(s, ":")
+		if idx == -1 {
+			break
+		}
+		n, err := strconv.Atoi(s[idx+1:])
+		if err != nil || n <= 0 {
+			break
+		}
+		numbers = append(numbers, n)
+		s = s[:idx]
+	}
+
+	switch {
+	case s == "" || len(numbers) == 0:
+		return lineDirective{}, false
+	case len(numbers) == 1:
+		return lineDirective{filename: s, sourceLine: numbers[0]}, true
+	default:
+		return lineDirective{filename: s, sourceLine: numbers[1], sourceCol: numbers[0]}, true
+	}
 }
 
 func (Cgo) Finish() {}
@@ -0,0 +1,71 @@
+package processors
+
+import (
+	"fmt"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestCgoMapsGeneratedIssuesToSource(t *testing.T) {
+	goCacheDir, err := ioutil.TempDir("", "gocache")
+	require.NoError(t, err)
+	defer os.RemoveAll(goCacheDir)
+
+	source := filepath.Join("testdata", "cgo", "main.go")
+	absSource, err := filepath.Abs(source)
+	require.NoError(t, err)
+
+	generated := filepath.Join(goCacheDir, "main.cgo1.go")
+	require.NoError(t, ioutil.WriteFile(generated, []byte(fmt.Sprintf(`// Code generated by cmd/cgo; DO NOT EDIT.
+
+//line %[1]s:1:1
+package main
+
+import _ "unsafe"
+
+//line %[1]s:8:1
+func main() {
+	p := (_Cfunc_malloc)(1)
+	_ = p
+}
+`, absSource)), os.ModePerm))
+
+	newIssue := func(linter, file string, line int) result.Issue {
+		return result.Issue{FromLinter: linter, Text: "issue", Pos: token.Position{Filename: file, Line: line, Column: 2}}
+	}
+
+	p := &Cgo{goCacheDir: goCacheDir, lineDirectives: map[string][]lineDirective{}}
+	processed, err := p.Process([]result.Issue{
+		newIssue("govet", source, 9),
+		newIssue("govet", generated, 10),       // duplicate of the source issue
+		newIssue("staticcheck", generated, 10), // only in the generated file
+		newIssue("staticcheck", generated, 1),  // no //line directive before it
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []result.Issue{
+		newIssue("govet", source, 9),
+		newIssue("staticcheck", source, 9),
+	}, processed)
+}
+
+func TestParseLineDirective(t *testing.T) {
+	d, ok := parseLineDirective(`C:\src\main.go:12:3`)
+	require.True(t, ok)
+	assert.Equal(t, lineDirective{filename: `C:\src\main.go`, sourceLine: 12, sourceCol: 3}, d)
+
+	d, ok = parseLineDirective("/src/main.go:12")
+	require.True(t, ok)
+	assert.Equal(t, lineDirective{filename: "/src/main.go", sourceLine: 12}, d)
+
+	_, ok = parseLineDirective("main.go")
+	assert.False(t, ok)
+}
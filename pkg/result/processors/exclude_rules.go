@@ -0,0 +1,238 @@
+package processors
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/astcache"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type excludeRule struct {
+	linters map[string]bool
+	path    *regexp.Regexp
+	text    *regexp.Regexp
+	kinds   map[string]bool
+}
+
+func (r excludeRule) match(i *result.Issue, kind func() string) bool {
+	if len(r.linters) != 0 && !r.linters[i.FromLinter] {
+		return false
+	}
+	if r.path != nil && !r.path.MatchString(i.FilePath()) {
+		return false
+	}
+	if r.text != nil && !r.text.MatchString(i.Text) {
+		return false
+	}
+	if len(r.kinds) != 0 && !r.kinds[kind()] {
+		return false
+	}
+
+	return true
+}
+
+type ExcludeRules struct {
+	rules    []excludeRule
+	astCache *astcache.Cache
+}
+
+var _ Processor = ExcludeRules{}
+
+// NewExcludeRules expects already resolved rules: see config.ResolveExcludeRules.
+func NewExcludeRules(rules []config.ExcludeRule, astCache *astcache.Cache) (*ExcludeRules, error) {
+	p := &ExcludeRules{astCache: astCache}
+	for _, r := range rules {
+		var er excludeRule
+		if len(r.Linters) != 0 {
+			er.linters = map[string]bool{}
+			for _, l := range r.Linters {
+				er.linters[l] = true
+			}
+		}
+
+		if r.Path != "" {
+			re, err := regexp.Compile(r.Path)
+			if err != nil {
+				return nil, fmt.Errorf("can't compile path regexp %q: %s", r.Path, err)
+			}
+			er.path = re
+		}
+
+		if r.Text != "" {
+			re, err := regexp.Compile("(?i)" + r.Text)
+			if err != nil {
+				return nil, fmt.Errorf("can't compile text regexp %q: %s", r.Text, err)
+			}
+			er.text = re
+		}
+
+		if len(r.Kind) != 0 {
+			er.kinds = map[string]bool{}
+			for _, k := range r.Kind {
+				if !isStmtKind(k) {
+					return nil, fmt.Errorf("unknown statement kind %q, only (%s) allowed", k, strings.Join(knownStmtKinds, "|"))
+				}
+				er.kinds[k] = true
+			}
+		}
+
+		p.rules = append(p.rules, er)
+	}
+
+	return p, nil
+}
+
+func (p ExcludeRules) Name() string {
+	return "exclude_rules"
+}
+
+func (p ExcludeRules) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.rules) == 0 {
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		kind, kindComputed := "", false
+		getKind := func() string {
+			if !kindComputed {
+				kind, kindComputed = p.stmtKindAt(i), true
+			}
+			return kind
+		}
+
+		for _, r := range p.rules {
+			if r.match(i, getKind) {
+				return false
+			}
+		}
+
+		return true
+	}), nil
+}
+
+func (p ExcludeRules) Finish() {}
+
+func (p ExcludeRules) stmtKindAt(i *result.Issue) string {
+	if p.astCache == nil {
+		return ""
+	}
+
+	f := p.astCache.Get(i.FilePath())
+	if f == nil || f.F == nil {
+		return ""
+	}
+
+	stmt := findStmtAt(f.F, f.Fset, i.Line(), i.Column())
+	if stmt == nil {
+		return ""
+	}
+
+	return stmtKind(stmt)
+}
+
+// findStmtAt returns the innermost statement containing the position. If the column is unknown
+// it returns the outermost statement starting on the line.
+func findStmtAt(f *ast.File, fset *token.FileSet, line, column int) ast.Stmt {
+	tf := fset.File(f.Pos())
+	if tf == nil || line <= 0 || line > tf.LineCount() {
+		return nil
+	}
+
+	var pos token.Pos
+	if column > 0 {
+		pos = tf.LineStart(line) + token.Pos(column-1)
+	}
+
+	var found ast.Stmt
+	ast.Inspect(f, func(node ast.Node) bool {
+		if node == nil {
+			return false
+		}
+
+		if column <= 0 {
+			if found != nil {
+				return false
+			}
+			if fset.Position(node.End()).Line < line || fset.Position(node.Pos()).Line > line {
+				return false
+			}
+			if stmt, ok := node.(ast.Stmt); ok && fset.Position(stmt.Pos()).Line == line {
+				found = stmt
+				return false
+			}
+			return true
+		}
+
+		if pos < node.Pos() || pos >= node.End() {
+			return false
+		}
+		if stmt, ok := node.(ast.Stmt); ok {
+			found = stmt
+		}
+		return true
+	})
+
+	return found
+}
+
+var knownStmtKinds = []string{
+	"assign", "block", "branch", "case", "decl", "defer", "expr", "for", "go",
+	"if", "incdec", "labeled", "range", "return", "select", "send", "switch",
+}
+
+func isStmtKind(kind string) bool {
+	for _, k := range knownStmtKinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+//nolint:gocyclo
+func stmtKind(stmt ast.Stmt) string {
+	switch stmt.(type) {
+	case *ast.AssignStmt:
+		return "assign"
+	case *ast.BlockStmt:
+		return "block"
+	case *ast.BranchStmt:
+		return "branch"
+	case *ast.CaseClause, *ast.CommClause:
+		return "case"
+	case *ast.DeclStmt:
+		return "decl"
+	case *ast.DeferStmt:
+		return "defer"
+	case *ast.ExprStmt:
+		return "expr"
+	case *ast.ForStmt:
+		return "for"
+	case *ast.GoStmt:
+		return "go"
+	case *ast.IfStmt:
+		return "if"
+	case *ast.IncDecStmt:
+		return "incdec"
+	case *ast.LabeledStmt:
+		return "labeled"
+	case *ast.RangeStmt:
+		return "range"
+	case *ast.ReturnStmt:
+		return "return"
+	case *ast.SelectStmt:
+		return "select"
+	case *ast.SendStmt:
+		return "send"
+	case *ast.SwitchStmt, *ast.TypeSwitchStmt:
+		return "switch"
+	}
+
+	return ""
+}
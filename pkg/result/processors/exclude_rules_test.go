@@ -0,0 +1,92 @@
+package processors
+
+import (
+	"go/token"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/astcache"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newTestExcludeRules(t *testing.T, rules []config.ExcludeRule, sets map[string][]config.ExcludeRule) *ExcludeRules {
+	resolved, err := config.ResolveExcludeRules(rules, sets)
+	assert.NoError(t, err)
+
+	p, err := NewExcludeRules(resolved, nil)
+	assert.NoError(t, err)
+	return p
+}
+
+func newLinterIssue(linter, file string, line, column int, text string) result.Issue {
+	return result.Issue{
+		FromLinter: linter,
+		Text:       text,
+		Pos: token.Position{
+			Filename: file,
+			Line:     line,
+			Column:   column,
+		},
+	}
+}
+
+func TestExcludeRules(t *testing.T) {
+	sets := map[string][]config.ExcludeRule{
+		"no-test-errcheck": {{Path: `_test\.go`, Linters: []string{"errcheck"}}},
+	}
+	p := newTestExcludeRules(t, []config.ExcludeRule{
+		{Text: "^G104"},
+		{Extends: []string{"no-test-errcheck"}},
+	}, sets)
+
+	processAssertEmpty(t, p,
+		newLinterIssue("errcheck", "a_test.go", 0, 0, "Error return value is not checked"),
+		newLinterIssue("gosec", "a.go", 0, 0, "g104: errors unhandled"))
+
+	processAssertSame(t, p,
+		newLinterIssue("errcheck", "a.go", 0, 0, "Error return value is not checked"),
+		newLinterIssue("govet", "a_test.go", 0, 0, "unreachable code"),
+		newLinterIssue("gosec", "a.go", 0, 0, "G401: Use of weak cryptographic primitive"))
+}
+
+func TestExcludeRulesInvalidRegexp(t *testing.T) {
+	p, err := NewExcludeRules([]config.ExcludeRule{{Path: "\\o"}}, nil)
+	assert.Error(t, err)
+	assert.Nil(t, p)
+}
+
+func TestExcludeRulesKind(t *testing.T) {
+	filename := filepath.Join("testdata", "exclude_rules_kind.go")
+	cache := astcache.LoadFromFilenames(logutils.NewStderrLog(""), filename)
+	p, err := NewExcludeRules([]config.ExcludeRule{{Linters: []string{"errcheck"}, Kind: []string{"defer"}}}, cache)
+	assert.NoError(t, err)
+
+	newErrcheckIssue := func(linter string, line, column int) result.Issue {
+		return result.Issue{
+			FromLinter: linter,
+			Text:       "Error return value of `f.Close` is not checked",
+			Pos:        token.Position{Filename: filename, Line: line, Column: column},
+		}
+	}
+
+	processAssertEmpty(t, p,
+		newErrcheckIssue("errcheck", 7, 13),  // defer f.Close()
+		newErrcheckIssue("errcheck", 7, 0),   // no column
+		newErrcheckIssue("errcheck", 11, 14)) // nested defer
+
+	processAssertSame(t, p,
+		newErrcheckIssue("errcheck", 8, 9),  // f.Close()
+		newErrcheckIssue("errcheck", 9, 10), // go f.Close()
+		newErrcheckIssue("errcheck", 10, 5), // assignment in if
+		newErrcheckIssue("govet", 7, 13))    // another linter
+}
+
+func TestExcludeRulesUnknownKind(t *testing.T) {
+	p, err := NewExcludeRules([]config.ExcludeRule{{Kind: []string{"goto"}}}, nil)
+	assert.Error(t, err)
+	assert.Nil(t, p)
+}
@@ -0,0 +1,114 @@
+package processors
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// Fixer applies suggested fixes of issues having enough confidence
+// and drops such issues: they are fixed.
+type Fixer struct {
+	enabled       bool
+	minConfidence float64
+	log           logutils.Log
+	edits         map[string][]result.TextEdit // file path to accepted edits mapping
+}
+
+var _ Processor = &Fixer{}
+
+func NewFixer(enabled bool, minConfidence float64, log logutils.Log) *Fixer {
+	return &Fixer{
+		enabled:       enabled,
+		minConfidence: minConfidence,
+		log:           log,
+		edits:         map[string][]result.TextEdit{},
+	}
+}
+
+func (Fixer) Name() string {
+	return "fixer"
+}
+
+func (f *Fixer) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !f.enabled {
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		return !f.acceptFix(i)
+	}), nil
+}
+
+func (f *Fixer) acceptFix(i *result.Issue) bool {
+	for _, fix := range i.SuggestedFixes {
+		if fix.Confidence < f.minConfidence || len(fix.TextEdits) == 0 {
+			continue
+		}
+
+		if f.overlapsAccepted(i.FilePath(), fix.TextEdits) {
+			// other linter fixes the same code, apply its fix first
+			f.log.Infof("Skip fix %q for %s:%d: it overlaps with another fix",
+				fix.Message, i.FilePath(), i.Line())
+			continue
+		}
+
+		f.edits[i.FilePath()] = append(f.edits[i.FilePath()], fix.TextEdits...)
+		return true
+	}
+
+	return false
+}
+
+func (f Fixer) overlapsAccepted(path string, edits []result.TextEdit) bool {
+	for _, e := range edits {
+		for _, accepted := range f.edits[path] {
+			if (e.Pos < accepted.End && accepted.Pos < e.End) || e.Pos == accepted.Pos {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (f Fixer) Finish() {
+	for path, edits := range f.edits {
+		if err := applyTextEdits(path, edits); err != nil {
+			f.log.Errorf("Failed to fix issues in file %s: %s", path, err)
+		}
+	}
+}
+
+func applyTextEdits(path string, edits []result.TextEdit) error {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(edits, func(i, j int) bool {
+		return edits[i].Pos > edits[j].Pos
+	})
+
+	for _, e := range edits {
+		if e.Pos < 0 || e.Pos > e.End || e.End > len(src) {
+			return fmt.Errorf("invalid edit [%d, %d) of %d bytes", e.Pos, e.End, len(src))
+		}
+
+		fixed := make([]byte, 0, len(src)-(e.End-e.Pos)+len(e.NewText))
+		fixed = append(fixed, src[:e.Pos]...)
+		fixed = append(fixed, e.NewText...)
+		src = append(fixed, src[e.End:]...)
+	}
+
+	return ioutil.WriteFile(path, src, fi.Mode())
+}
@@ -0,0 +1,83 @@
+package processors
+
+import (
+	"go/token"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func makeFixableIssue(t *testing.T, confidence float64) (result.Issue, string) {
+	f, err := ioutil.TempFile("", "fixer")
+	require.NoError(t, err)
+	_, err = f.WriteString("var a = 1\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	return result.Issue{
+		Pos:  token.Position{Filename: f.Name(), Line: 1},
+		Text: "a",
+		SuggestedFixes: []result.SuggestedFix{{
+			Message:    "rename",
+			TextEdits:  []result.TextEdit{{Pos: 4, End: 5, NewText: "b"}},
+			Confidence: confidence,
+		}},
+	}, f.Name()
+}
+
+func assertFileContent(t *testing.T, path, expected string) {
+	content, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(content))
+}
+
+func TestFixerAppliesConfidentFix(t *testing.T) {
+	i, path := makeFixableIssue(t, 1)
+	defer os.Remove(path)
+
+	p := NewFixer(true, 0.9, logutils.NewStderrLog(""))
+	processAssertEmpty(t, p, i)
+	p.Finish()
+
+	assertFileContent(t, path, "var b = 1\n")
+}
+
+func TestFixerSkipsNotConfidentFix(t *testing.T) {
+	i, path := makeFixableIssue(t, 0.5)
+	defer os.Remove(path)
+
+	p := NewFixer(true, 0.9, logutils.NewStderrLog(""))
+	processAssertSame(t, p, i)
+	p.Finish()
+
+	assertFileContent(t, path, "var a = 1\n")
+}
+
+func TestFixerSkipsOverlappingFix(t *testing.T) {
+	i, path := makeFixableIssue(t, 1)
+	defer os.Remove(path)
+
+	p := NewFixer(true, 0.9, logutils.NewStderrLog(""))
+	processAssertEmpty(t, p, i)
+	processAssertSame(t, p, i)
+	p.Finish()
+
+	assertFileContent(t, path, "var b = 1\n")
+}
+
+func TestFixerDisabled(t *testing.T) {
+	i, path := makeFixableIssue(t, 1)
+	defer os.Remove(path)
+
+	p := NewFixer(false, 0.9, logutils.NewStderrLog(""))
+	processAssertSame(t, p, i)
+	p.Finish()
+
+	assertFileContent(t, path, "var a = 1\n")
+}
@@ -0,0 +1,219 @@
+package processors
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const IgnoreFileName = ".golangciignore"
+
+type ignorePattern struct {
+	re      *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// IgnoreFile excludes issues from paths listed in a .golangciignore file.
+// Patterns have gitignore syntax, they are matched relatively to the directory
+// containing the file and the last matching pattern wins.
+type IgnoreFile struct {
+	root     string
+	patterns []ignorePattern
+}
+
+var _ Processor = IgnoreFile{}
+
+// NewIgnoreFile reads patterns from the ignore file at path. A missing file isn't an error:
+// the processor just doesn't filter anything then.
+func NewIgnoreFile(path string) (*IgnoreFile, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("can't get absolute path for %q: %s", path, err)
+	}
+
+	f, err := os.Open(absPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &IgnoreFile{}, nil
+		}
+		return nil, fmt.Errorf("can't open %s: %s", path, err)
+	}
+	defer f.Close()
+
+	p, err := newIgnoreFile(filepath.Dir(absPath), f)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse %s: %s", path, err)
+	}
+
+	return p, nil
+}
+
+func newIgnoreFile(root string, r io.Reader) (*IgnoreFile, error) {
+	p := &IgnoreFile{root: root}
+
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		pattern, err := parseIgnorePattern(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", lineNumber, err)
+		}
+		p.patterns = append(p.patterns, *pattern)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func parseIgnorePattern(line string) (*ignorePattern, error) {
+	var p ignorePattern
+
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\!`) || strings.HasPrefix(line, `\#`) {
+		line = line[1:]
+	}
+
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimSuffix(line, "/")
+	}
+
+	// like in gitignore a pattern without a slash matches a name at any level
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+
+	var expr string
+	if anchored {
+		expr = "^" + globToRegexp(line) + "$"
+	} else {
+		expr = "^(.*/)?" + globToRegexp(line) + "$"
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %s", line, err)
+	}
+	p.re = re
+
+	return &p, nil
+}
+
+func globToRegexp(glob string) string {
+	var sb strings.Builder
+	for i := 0; i < len(glob); i++ {
+		c := glob[i]
+		switch {
+		case strings.HasPrefix(glob[i:], "**/"):
+			sb.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			sb.WriteString(".*")
+			i++
+		case c == '*':
+			sb.WriteString("[^/]*")
+		case c == '?':
+			sb.WriteString("[^/]")
+		case c == '[':
+			end := strings.IndexByte(glob[i:], ']')
+			if end == -1 {
+				sb.WriteString(`\[`)
+				continue
+			}
+			class := glob[i+1 : i+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			sb.WriteString("[" + class + "]")
+			i += end
+		case c == '\\' && i+1 < len(glob):
+			i++
+			sb.WriteString(regexp.QuoteMeta(string(glob[i])))
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	return sb.String()
+}
+
+func (p IgnoreFile) Name() string {
+	return "ignore_file"
+}
+
+func (p IgnoreFile) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.patterns) == 0 {
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		return !p.isIgnored(i.FilePath())
+	}), nil
+}
+
+func (p IgnoreFile) isIgnored(path string) bool {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+
+	relPath, err := filepath.Rel(p.root, absPath)
+	if err != nil {
+		return false
+	}
+
+	relPath = filepath.ToSlash(relPath)
+	if relPath == ".." || strings.HasPrefix(relPath, "../") {
+		return false // not under the ignore file dir
+	}
+
+	ignored := false
+	for _, pattern := range p.patterns {
+		if pattern.matches(relPath) {
+			ignored = !pattern.negate
+		}
+	}
+
+	return ignored
+}
+
+// matches checks whether the pattern matches the file path or any of its parent dirs.
+func (p ignorePattern) matches(path string) bool {
+	if !p.dirOnly && p.re.MatchString(path) {
+		return true
+	}
+
+	for dir := pathDir(path); dir != ""; dir = pathDir(dir) {
+		if p.re.MatchString(dir) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func pathDir(path string) string {
+	i := strings.LastIndexByte(path, '/')
+	if i == -1 {
+		return ""
+	}
+
+	return path[:i]
+}
+
+func (p IgnoreFile) Finish() {}
@@ -0,0 +1,62 @@
+package processors
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestIgnoreFile(t *testing.T, lines ...string) *IgnoreFile {
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+
+	p, err := newIgnoreFile(wd, strings.NewReader(strings.Join(lines, "\n")))
+	assert.NoError(t, err)
+	return p
+}
+
+func TestIgnoreFileGlob(t *testing.T) {
+	p := newTestIgnoreFile(t, "# generated code", "", "*.pb.go", "/gen/", "docs/**/*.go")
+
+	processAssertEmpty(t, p,
+		newFileIssue("a.pb.go"),
+		newFileIssue(filepath.Join("a", "b", "c.pb.go")),
+		newFileIssue(filepath.Join("gen", "a.go")),
+		newFileIssue(filepath.Join("gen", "sub", "a.go")),
+		newFileIssue(filepath.Join("docs", "a.go")),
+		newFileIssue(filepath.Join("docs", "x", "y", "a.go")))
+
+	processAssertSame(t, p,
+		newFileIssue("a.go"),
+		newFileIssue(filepath.Join("a", "gen", "b.go")),
+		newFileIssue("gen.go"),
+		newFileIssue(filepath.Join("docs", "a.md")))
+}
+
+func TestIgnoreFileNegation(t *testing.T) {
+	p := newTestIgnoreFile(t, "vendor/", "!vendor/mine/", "*_mock.go", "!keep_mock.go")
+
+	processAssertEmpty(t, p,
+		newFileIssue(filepath.Join("vendor", "a", "a.go")),
+		newFileIssue(filepath.Join("vendor", "mine2", "a.go")),
+		newFileIssue("a_mock.go"))
+
+	processAssertSame(t, p,
+		newFileIssue(filepath.Join("vendor", "mine", "a.go")),
+		newFileIssue(filepath.Join("vendor", "mine", "sub", "a.go")),
+		newFileIssue(filepath.Join("pkg", "keep_mock.go")))
+}
+
+func TestIgnoreFileOutsideOfRoot(t *testing.T) {
+	p := newTestIgnoreFile(t, "*.go")
+	processAssertSame(t, p, newFileIssue(filepath.Join("..", "a.go")))
+}
+
+func TestIgnoreFileMissing(t *testing.T) {
+	p, err := NewIgnoreFile(filepath.Join("testdata", "no_such_dir", IgnoreFileName))
+	assert.NoError(t, err)
+	processAssertSame(t, p, newFileIssue("a.go"))
+}
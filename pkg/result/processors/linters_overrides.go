@@ -0,0 +1,96 @@
+package processors
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type lintersOverride struct {
+	path    *regexp.Regexp
+	enable  map[string]bool
+	disable map[string]bool
+}
+
+// LintersOverrides drops issues of linters disabled for their files by linters overrides.
+type LintersOverrides struct {
+	overrides []lintersOverride
+}
+
+var _ Processor = LintersOverrides{}
+
+func NewLintersOverrides(overrides []config.LintersOverride, dbManager *lintersdb.Manager) (*LintersOverrides, error) {
+	p := &LintersOverrides{}
+	for _, o := range overrides {
+		re, err := regexp.Compile(o.Path)
+		if err != nil {
+			return nil, fmt.Errorf("can't compile linters override path regexp %q: %s", o.Path, err)
+		}
+
+		lo := lintersOverride{
+			path:    re,
+			enable:  map[string]bool{},
+			disable: map[string]bool{},
+		}
+		for _, name := range lintersOverrideNames(o.Enable, dbManager) {
+			lo.enable[name] = true
+		}
+		for _, name := range lintersOverrideNames(o.Disable, dbManager) {
+			lo.disable[name] = true
+		}
+		p.overrides = append(p.overrides, lo)
+	}
+
+	return p, nil
+}
+
+// lintersOverrideNames normalizes names of linters to match issues: issues are reported
+// by children of metalinters (e.g. megacheck) and by main names of linters with aliases (e.g. gas).
+func lintersOverrideNames(names []string, dbManager *lintersdb.Manager) []string {
+	var ret []string
+	for _, name := range names {
+		if metaLinter := dbManager.GetMetaLinter(name); metaLinter != nil {
+			ret = append(ret, metaLinter.DefaultChildLinterNames()...)
+			continue
+		}
+
+		if lc := dbManager.GetLinterConfig(name); lc != nil {
+			name = lc.Name()
+		}
+		ret = append(ret, name)
+	}
+
+	return ret
+}
+
+func (p LintersOverrides) Name() string {
+	return "linters_overrides"
+}
+
+func (p LintersOverrides) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.overrides) == 0 {
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(i *result.Issue) bool {
+		enabled := true
+		for _, o := range p.overrides {
+			if !o.path.MatchString(i.FilePath()) {
+				continue
+			}
+
+			if o.disable[i.FromLinter] {
+				enabled = false
+			} else if o.enable[i.FromLinter] {
+				enabled = true
+			}
+		}
+
+		return enabled
+	}), nil
+}
+
+func (p LintersOverrides) Finish() {}
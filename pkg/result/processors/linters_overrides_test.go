@@ -0,0 +1,53 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
+)
+
+func TestLintersOverrides(t *testing.T) {
+	p, err := NewLintersOverrides([]config.LintersOverride{
+		{Path: `^internal/legacy/`, Disable: []string{"gocyclo", "dupl"}},
+		{Path: `^internal/legacy/core/`, Enable: []string{"dupl"}},
+	}, lintersdb.NewManager())
+	require.NoError(t, err)
+
+	processAssertEmpty(t, p,
+		newLinterIssue("gocyclo", "internal/legacy/a.go", 0, 0, "cyclomatic complexity 31 of func `f` is high (> 30)"),
+		newLinterIssue("dupl", "internal/legacy/a.go", 0, 0, "8-20 lines are duplicate of `internal/legacy/b.go:8-20`"),
+		newLinterIssue("gocyclo", "internal/legacy/core/a.go", 0, 0, "cyclomatic complexity 31 of func `f` is high (> 30)"))
+
+	processAssertSame(t, p,
+		newLinterIssue("gocyclo", "internal/a.go", 0, 0, "cyclomatic complexity 31 of func `f` is high (> 30)"),
+		newLinterIssue("govet", "internal/legacy/a.go", 0, 0, "unreachable code"),
+		newLinterIssue("dupl", "internal/legacy/core/a.go", 0, 0, "8-20 lines are duplicate of `internal/legacy/core/b.go:8-20`"))
+}
+
+func TestLintersOverridesAlternativeNames(t *testing.T) {
+	p, err := NewLintersOverrides([]config.LintersOverride{
+		{Path: `_test\.go$`, Disable: []string{"gas", "megacheck"}},
+		{Path: `^cmd/`, Disable: []string{"vet"}},
+	}, lintersdb.NewManager())
+	require.NoError(t, err)
+
+	processAssertEmpty(t, p,
+		newLinterIssue("gosec", "a_test.go", 0, 0, "G104: Errors unhandled."),
+		newLinterIssue("staticcheck", "a_test.go", 0, 0, "SA4006: this value of `err` is never used"),
+		newLinterIssue("unused", "a_test.go", 0, 0, "`f` is unused"),
+		newLinterIssue("govet", "cmd/a.go", 0, 0, "unreachable code"))
+
+	processAssertSame(t, p,
+		newLinterIssue("gosec", "a.go", 0, 0, "G104: Errors unhandled."),
+		newLinterIssue("govet", "a_test.go", 0, 0, "unreachable code"))
+}
+
+func TestLintersOverridesInvalidRegexp(t *testing.T) {
+	p, err := NewLintersOverrides([]config.LintersOverride{{Path: "\\o"}}, lintersdb.NewManager())
+	assert.Error(t, err)
+	assert.Nil(t, p)
+}
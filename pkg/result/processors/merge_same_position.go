@@ -0,0 +1,67 @@
+package processors
+
+import (
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type samePositionKey struct {
+	filename     string
+	line, column int
+	linter       string
+}
+
+// MergeSamePosition merges issues of one linter at the same position into one issue
+// with a multi-line text.
+type MergeSamePosition struct {
+	enabled bool
+}
+
+var _ Processor = MergeSamePosition{}
+
+func NewMergeSamePosition(enabled bool) *MergeSamePosition {
+	return &MergeSamePosition{
+		enabled: enabled,
+	}
+}
+
+func (MergeSamePosition) Name() string {
+	return "merge_same_position"
+}
+
+func (p MergeSamePosition) Process(issues []result.Issue) ([]result.Issue, error) {
+	if !p.enabled || len(issues) < 2 {
+		return issues, nil
+	}
+
+	keyToIndex := map[samePositionKey]int{}
+	var texts [][]string
+	var retIssues []result.Issue
+	for _, i := range issues {
+		key := samePositionKey{
+			filename: i.FilePath(),
+			line:     i.Line(),
+			column:   i.Column(),
+			linter:   i.FromLinter,
+		}
+
+		if idx, ok := keyToIndex[key]; ok {
+			texts[idx] = append(texts[idx], i.Text)
+			retIssues[idx].SuggestedFixes = append(retIssues[idx].SuggestedFixes, i.SuggestedFixes...)
+			continue
+		}
+
+		keyToIndex[key] = len(retIssues)
+		texts = append(texts, []string{i.Text})
+		retIssues = append(retIssues, i)
+	}
+
+	for idx := range retIssues {
+		retIssues[idx].Text = strings.Join(texts[idx], "\n")
+	}
+
+	return retIssues, nil
+}
+
+func (MergeSamePosition) Finish() {}
@@ -0,0 +1,34 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestMergeSamePosition(t *testing.T) {
+	p := NewMergeSamePosition(true)
+
+	merged := newLinterIssue("govet", "f.go", 1, 2, "first\nsecond")
+	processAssertSame(t, p, merged) // nothing to merge
+
+	issues, err := p.Process([]result.Issue{
+		newLinterIssue("govet", "f.go", 1, 2, "first"),
+		newLinterIssue("govet", "f.go", 1, 3, "other column"),
+		newLinterIssue("govet", "f.go", 1, 2, "second"),
+		newLinterIssue("errcheck", "f.go", 1, 2, "other linter"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []result.Issue{
+		merged,
+		newLinterIssue("govet", "f.go", 1, 3, "other column"),
+		newLinterIssue("errcheck", "f.go", 1, 2, "other linter"),
+	}, issues)
+}
+
+func TestMergeSamePositionDisabled(t *testing.T) {
+	p := NewMergeSamePosition(false)
+	processAssertSame(t, p, newLinterIssue("govet", "f.go", 1, 2, "first"), newLinterIssue("govet", "f.go", 1, 2, "second"))
+}
@@ -0,0 +1,41 @@
+package processors
+
+import (
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// MessagePrefix prepends configured prefixes to texts of issues of linters.
+type MessagePrefix struct {
+	prefixes map[string]string // linter name to prefix
+}
+
+var _ Processor = MessagePrefix{}
+
+func NewMessagePrefix(prefixes map[string]string) *MessagePrefix {
+	return &MessagePrefix{
+		prefixes: prefixes,
+	}
+}
+
+func (MessagePrefix) Name() string {
+	return "message_prefix"
+}
+
+func (p MessagePrefix) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.prefixes) == 0 {
+		return issues, nil
+	}
+
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		prefix := p.prefixes[i.FromLinter]
+		if prefix == "" {
+			return i
+		}
+
+		newI := *i
+		newI.Text = prefix + i.Text
+		return &newI
+	}), nil
+}
+
+func (MessagePrefix) Finish() {}
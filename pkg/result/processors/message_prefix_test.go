@@ -0,0 +1,23 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestMessagePrefix(t *testing.T) {
+	p := NewMessagePrefix(map[string]string{"depguard": "[ARCH] "})
+
+	issues, err := p.Process([]result.Issue{
+		{FromLinter: "depguard", Text: "import is not allowed"},
+		{FromLinter: "govet", Text: "unreachable code"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []result.Issue{
+		{FromLinter: "depguard", Text: "[ARCH] import is not allowed"},
+		{FromLinter: "govet", Text: "unreachable code"},
+	}, issues)
+}
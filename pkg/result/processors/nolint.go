@@ -1,12 +1,16 @@
 package processors
 
 import (
+	"encoding/json"
 	"fmt"
 	"go/ast"
 	"go/token"
+	"io"
 	"sort"
 	"strings"
 
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/golinters"
 	"github.com/golangci/golangci-lint/pkg/lint/astcache"
 	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
 	"github.com/golangci/golangci-lint/pkg/logutils"
@@ -15,19 +19,35 @@ import (
 
 var nolintDebugf = logutils.Debug("nolint")
 
+// nolintScope is a specificity of a directive: greater is more specific.
+type nolintScope int
+
+const (
+	nolintScopeFile nolintScope = iota // the directive precedes the package clause
+	nolintScopeFunc                    // the directive precedes a function
+	nolintScopeLine                    // inline directives and directives preceding other nodes
+)
+
 type ignoredRange struct {
 	linters []string
 	result.Range
-	col int
+	col   int
+	scope nolintScope
+	text  string // text of the directive comment
 }
 
 func (i *ignoredRange) doesMatch(issue *result.Issue) bool {
-	if issue.Line() < i.From || issue.Line() > i.To {
-		return false
-	}
+	return i.coversLine(issue) && i.coversLinter(issue)
+}
 
+func (i *ignoredRange) coversLine(issue *result.Issue) bool {
+	return issue.Line() >= i.From && issue.Line() <= i.To
+}
+
+func (i *ignoredRange) coversLinter(issue *result.Issue) bool {
 	if len(i.linters) == 0 {
-		return true
+		// nolintlint reports problems of the directive itself: don't hide them by it
+		return issue.FromLinter != golinters.Nolintlint{}.Name()
 	}
 
 	for _, linterName := range i.linters {
@@ -51,17 +71,51 @@ type Nolint struct {
 	dbManager *lintersdb.Manager
 	log       logutils.Log
 
+	// mostSpecific enables precedence of directives: see shouldPassIssue
+	mostSpecific bool
+
+	// report is the format of the report of suppressed issues printed by Finish, "" to not print it
+	report       string
+	reportWriter io.Writer
+	suppressed   map[nolintDirective][]result.Issue
+
 	unknownLintersSet map[string]bool
 }
 
-func NewNolint(astCache *astcache.Cache, log logutils.Log) *Nolint {
+// nolintDirective identifies a //nolint comment in reports
+type nolintDirective struct {
+	file      string
+	line, col int
+	text      string
+}
+
+func NewNolint(astCache *astcache.Cache, log logutils.Log, mostSpecific bool, report string) (*Nolint, error) {
+	if report != "" && !isNolintReportFormat(report) {
+		return nil, fmt.Errorf("unknown nolint report format %q, only (%s) allowed",
+			report, strings.Join(config.NolintReportFormats, "|"))
+	}
+
 	return &Nolint{
 		cache:             filesCache{},
 		astCache:          astCache,
 		dbManager:         lintersdb.NewManager(), // TODO: get it in constructor
 		log:               log,
+		mostSpecific:      mostSpecific,
+		report:            report,
+		reportWriter:      logutils.StdErr,
+		suppressed:        map[nolintDirective][]result.Issue{},
 		unknownLintersSet: map[string]bool{},
+	}, nil
+}
+
+func isNolintReportFormat(format string) bool {
+	for _, f := range config.NolintReportFormats {
+		if f == format {
+			return true
+		}
 	}
+
+	return false
 }
 
 var _ Processor = &Nolint{}
@@ -120,18 +174,63 @@ func (p *Nolint) buildIgnoredRangesForFile(f *ast.File, fset *token.FileSet, fil
 }
 
 func (p *Nolint) shouldPassIssue(i *result.Issue) (bool, error) {
+	if !isGoFile(i) {
+		return true, nil // non-Go files have no nolint directives
+	}
+
 	fd, err := p.getOrCreateFileData(i)
 	if err != nil {
 		return false, err
 	}
 
-	for _, ir := range fd.ignoredRanges {
-		if ir.doesMatch(i) {
-			return false, nil
+	ir := findIgnoringRange(fd.ignoredRanges, i)
+	if p.mostSpecific {
+		ir = findMostSpecificIgnoringRange(fd.ignoredRanges, i)
+	}
+	if ir == nil {
+		return true, nil
+	}
+
+	if p.report != "" {
+		d := nolintDirective{file: i.FilePath(), line: ir.From, col: ir.col, text: ir.text}
+		p.suppressed[d] = append(p.suppressed[d], *i)
+	}
+	return false, nil
+}
+
+func findIgnoringRange(ranges []ignoredRange, i *result.Issue) *ignoredRange {
+	for idx := range ranges {
+		if ranges[idx].doesMatch(i) {
+			return &ranges[idx]
 		}
 	}
 
-	return true, nil
+	return nil
+}
+
+// findMostSpecificIgnoringRange lets the most specific directives covering the issue line (line > func > file)
+// decide whether to ignore the issue. A less specific directive for all linters still ignores it:
+// it conflicts with the more specific one and conflicts resolve to ignoring.
+func findMostSpecificIgnoringRange(ranges []ignoredRange, i *result.Issue) *ignoredRange {
+	maxScope := nolintScope(-1)
+	for _, ir := range ranges {
+		if ir.coversLine(i) && ir.scope > maxScope {
+			maxScope = ir.scope
+		}
+	}
+
+	for idx := range ranges {
+		ir := &ranges[idx]
+		if !ir.coversLine(i) || !ir.coversLinter(i) {
+			continue
+		}
+
+		if ir.scope == maxScope || len(ir.linters) == 0 {
+			return ir
+		}
+	}
+
+	return nil
 }
 
 type rangeExpander struct {
@@ -165,6 +264,12 @@ func (e *rangeExpander) Visit(node ast.Node) ast.Visitor {
 	if expandedRange.To < nodeEndLine {
 		expandedRange.To = nodeEndLine
 	}
+	switch node.(type) {
+	case *ast.File:
+		expandedRange.scope = nolintScopeFile
+	case *ast.FuncDecl:
+		expandedRange.scope = nolintScopeFunc
+	}
 	nolintDebugf("found range is %v for node %#v [%d;%d], expanded range is %v",
 		*foundRange, node, nodeStartLine, nodeEndLine, expandedRange)
 	e.expandedRanges = append(e.expandedRanges, expandedRange)
@@ -192,6 +297,7 @@ func (p *Nolint) extractInlineRangeFromComment(text string, g ast.Node, fset *to
 		return nil
 	}
 
+	directive := text
 	buildRange := func(linters []string) *ignoredRange {
 		pos := fset.Position(g.Pos())
 		return &ignoredRange{
@@ -201,6 +307,8 @@ func (p *Nolint) extractInlineRangeFromComment(text string, g ast.Node, fset *to
 			},
 			col:     pos.Column,
 			linters: linters,
+			scope:   nolintScopeLine,
+			text:    "//" + directive,
 		}
 	}
 
@@ -242,6 +350,12 @@ func (p *Nolint) extractInlineRangeFromComment(text string, g ast.Node, fset *to
 }
 
 func (p Nolint) Finish() {
+	if p.report != "" {
+		if err := p.printReport(); err != nil {
+			p.log.Warnf("Can't print nolint report: %s", err)
+		}
+	}
+
 	if len(p.unknownLintersSet) == 0 {
 		return
 	}
@@ -254,3 +368,71 @@ func (p Nolint) Finish() {
 
 	p.log.Warnf("Found unknown linters in //nolint directives: %s", strings.Join(unknownLinters, ", "))
 }
+
+type nolintReportIssue struct {
+	FromLinter string
+	Pos        token.Position
+}
+
+type nolintReportDirective struct {
+	Pos    token.Position
+	Text   string
+	Issues []nolintReportIssue
+}
+
+// buildReport returns directives which suppressed issues ordered by their positions.
+func (p Nolint) buildReport() []nolintReportDirective {
+	var ret []nolintReportDirective
+	for d, issues := range p.suppressed {
+		rd := nolintReportDirective{
+			Pos:  token.Position{Filename: d.file, Line: d.line, Column: d.col},
+			Text: d.text,
+		}
+		for _, i := range issues {
+			rd.Issues = append(rd.Issues, nolintReportIssue{FromLinter: i.FromLinter, Pos: i.Pos})
+		}
+		ret = append(ret, rd)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		a, b := ret[i].Pos, ret[j].Pos
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+
+	return ret
+}
+
+func (p Nolint) printReport() error {
+	report := p.buildReport()
+	if p.report == config.NolintReportJSON {
+		if report == nil {
+			report = []nolintReportDirective{}
+		}
+		data, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(p.reportWriter, string(data))
+		return err
+	}
+
+	for _, d := range report {
+		if _, err := fmt.Fprintf(p.reportWriter, "%s:%d:%d: %s suppressed %d issue(s):\n",
+			d.Pos.Filename, d.Pos.Line, d.Pos.Column, d.Text, len(d.Issues)); err != nil {
+			return err
+		}
+		for _, i := range d.Issues {
+			if _, err := fmt.Fprintf(p.reportWriter, "  %s (%s)\n", i.Pos, i.FromLinter); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package processors
 
 import (
+	"bytes"
 	"fmt"
 	"go/token"
 	"path/filepath"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/golangci/golangci-lint/pkg/lint/astcache"
 	"github.com/golangci/golangci-lint/pkg/logutils"
@@ -36,7 +38,8 @@ func newTestNolintProcessor(log logutils.Log) *Nolint {
 		filepath.Join("testdata", "nolint2.go"),
 		filepath.Join("testdata", "nolint_bad_names.go"),
 	)
-	return NewNolint(cache, log)
+	p, _ := NewNolint(cache, log, false, "") // the empty report format is valid
+	return p
 }
 
 func getOkLogger(ctrl *gomock.Controller) *logutils.MockLog {
@@ -65,6 +68,7 @@ func TestNolint(t *testing.T) {
 	processAssertSame(t, p, newNolintFileIssue(5, "gofmtA")) // check different name
 
 	processAssertEmpty(t, p, newNolintFileIssue(6, "any"))
+	processAssertSame(t, p, newNolintFileIssue(6, "nolintlint")) // directive doesn't hide its own problems
 	processAssertEmpty(t, p, newNolintFileIssue(7, "any"))
 
 	processAssertSame(t, p, newNolintFileIssue(1, "golint")) // no directive
@@ -224,3 +228,86 @@ func TestIgnoredRangeMatches(t *testing.T) {
 		assert.Equal(t, testcase.expected, ir.doesMatch(&testcase.issue), testcase.doc)
 	}
 }
+
+func TestNolintMostSpecific(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log := getOkLogger(ctrl)
+	scopesFile := filepath.Join("testdata", "nolint_scopes.go")
+	bareFile := filepath.Join("testdata", "nolint_scopes_bare.go")
+	cache := astcache.LoadFromFilenames(log, scopesFile, bareFile)
+
+	newIssue := func(file string, line int, fromLinter string) result.Issue {
+		i := newNolintFileIssue(line, fromLinter)
+		i.Pos.Filename = file
+		return i
+	}
+
+	p, err := NewNolint(cache, log, true, "")
+	require.NoError(t, err)
+	defer p.Finish()
+
+	// line directive decides
+	processAssertEmpty(t, p, newIssue(scopesFile, 8, "errcheck"))
+	processAssertSame(t, p, newIssue(scopesFile, 8, "govet"))
+	processAssertSame(t, p, newIssue(scopesFile, 8, "unparam"))
+
+	// func directive decides
+	processAssertEmpty(t, p, newIssue(scopesFile, 9, "unparam"))
+	processAssertSame(t, p, newIssue(scopesFile, 9, "govet"))
+
+	// file directive decides
+	processAssertEmpty(t, p, newIssue(scopesFile, 13, "govet"))
+	processAssertSame(t, p, newIssue(scopesFile, 13, "errcheck"))
+
+	// file directive for all linters conflicts with the line directive
+	processAssertEmpty(t, p, newIssue(bareFile, 7, "errcheck"))
+	processAssertEmpty(t, p, newIssue(bareFile, 7, "govet"))
+
+	// by default any directive hides issues
+	p, err = NewNolint(cache, log, false, "")
+	require.NoError(t, err)
+	processAssertEmpty(t, p, newIssue(scopesFile, 8, "govet"))
+	processAssertEmpty(t, p, newIssue(scopesFile, 8, "unparam"))
+	processAssertEmpty(t, p, newIssue(bareFile, 7, "govet"))
+}
+
+func TestNolintReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log := getOkLogger(ctrl)
+	cache := astcache.LoadFromFilenames(log, filepath.Join("testdata", "nolint.go"))
+
+	for _, format := range []string{"text", "json"} {
+		p, err := NewNolint(cache, log, false, format)
+		require.NoError(t, err)
+		var out bytes.Buffer
+		p.reportWriter = &out
+
+		processAssertEmpty(t, p, newNolintFileIssue(20, "errcheck"), newNolintFileIssue(21, "govet"))
+		processAssertSame(t, p, newNolintFileIssue(1, "errcheck"))
+
+		report := p.buildReport()
+		require.Len(t, report, 1)
+		assert.Equal(t, 19, report[0].Pos.Line)
+		assert.Equal(t, "//nolint", report[0].Text)
+		assert.Equal(t, []nolintReportIssue{
+			{FromLinter: "errcheck", Pos: newNolintFileIssue(20, "").Pos},
+			{FromLinter: "govet", Pos: newNolintFileIssue(21, "").Pos},
+		}, report[0].Issues)
+
+		p.Finish()
+		if format == "json" {
+			assert.Contains(t, out.String(), `"Text":"//nolint","Issues":[{"FromLinter":"errcheck"`)
+		} else {
+			nolintFile := filepath.Join("testdata", "nolint.go")
+			assert.Equal(t, fmt.Sprintf("%s:19:1: //nolint suppressed 2 issue(s):\n  %s:20 (errcheck)\n  %s:21 (govet)\n",
+				nolintFile, nolintFile, nolintFile), out.String())
+		}
+	}
+
+	_, err := NewNolint(cache, log, false, "xml")
+	assert.Error(t, err)
+}
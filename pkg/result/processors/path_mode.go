@@ -0,0 +1,67 @@
+package processors
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// PathMode normalizes issues paths for tools which can't work with native paths,
+// e.g. with backslashes on Windows.
+type PathMode struct {
+	mode string
+	wd   string
+}
+
+var _ Processor = PathMode{}
+
+func NewPathMode(mode string) (*PathMode, error) {
+	switch mode {
+	case "", config.PathModeNative, config.PathModeUnix:
+		return &PathMode{mode: mode}, nil
+	case config.PathModeAbs:
+		wd, err := fsutils.Getwd()
+		if err != nil {
+			return nil, fmt.Errorf("can't get working dir: %s", err)
+		}
+		return &PathMode{mode: mode, wd: wd}, nil
+	}
+
+	return nil, fmt.Errorf("invalid path mode %q, only (%s) allowed", mode, strings.Join(config.PathModes, "|"))
+}
+
+func (p PathMode) Name() string {
+	return "path_mode"
+}
+
+func (p PathMode) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.mode == "" || p.mode == config.PathModeNative {
+		return issues, nil
+	}
+
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		newI := i
+		newI.Pos.Filename = p.makePath(i.FilePath())
+		return newI
+	}), nil
+}
+
+func (p PathMode) makePath(path string) string {
+	switch p.mode {
+	case config.PathModeUnix:
+		// don't use filepath.ToSlash: it does nothing with backslashes on unix
+		return strings.Replace(path, `\`, "/", -1)
+	case config.PathModeAbs:
+		if !filepath.IsAbs(path) {
+			return filepath.Join(p.wd, path)
+		}
+	}
+
+	return path
+}
+
+func (p PathMode) Finish() {}
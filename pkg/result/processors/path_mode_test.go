@@ -0,0 +1,45 @@
+package processors
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestPathModeUnix(t *testing.T) {
+	p, err := NewPathMode(config.PathModeUnix)
+	require.NoError(t, err)
+
+	processAssertSame(t, p, newFileIssue("a/b.go"))
+	issues := process(t, p, newFileIssue(`pkg\a\b.go`))
+	assert.Equal(t, []result.Issue{newFileIssue("pkg/a/b.go")}, issues)
+}
+
+func TestPathModeNative(t *testing.T) {
+	p, err := NewPathMode(config.PathModeNative)
+	require.NoError(t, err)
+
+	processAssertSame(t, p, newFileIssue(`pkg\a\b.go`))
+}
+
+func TestPathModeAbs(t *testing.T) {
+	p, err := NewPathMode(config.PathModeAbs)
+	require.NoError(t, err)
+
+	wd, err := fsutils.Getwd()
+	require.NoError(t, err)
+
+	issues := process(t, p, newFileIssue(filepath.Join("a", "b.go")))
+	assert.Equal(t, []result.Issue{newFileIssue(filepath.Join(wd, "a", "b.go"))}, issues)
+}
+
+func TestPathModeInvalid(t *testing.T) {
+	_, err := NewPathMode("relative")
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io/ioutil"
+	"sync"
 
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
@@ -13,16 +14,26 @@ type linesCache [][]byte
 type filesLineCache map[string]linesCache
 
 type SourceCode struct {
-	cache filesLineCache
-	log   logutils.Log
+	cache        filesLineCache
+	maxOpenFiles int
+	readFile     func(filename string) ([]byte, error)
+	log          logutils.Log
 }
 
 var _ Processor = SourceCode{}
 
-func NewSourceCode(log logutils.Log) *SourceCode {
+// NewSourceCode creates the processor reading files of issues one by one or, if maxOpenFiles > 1,
+// concurrently, but no more than maxOpenFiles of them are open at once.
+func NewSourceCode(maxOpenFiles int, log logutils.Log) *SourceCode {
+	if maxOpenFiles <= 0 {
+		maxOpenFiles = 1
+	}
+
 	return &SourceCode{
-		cache: filesLineCache{},
-		log:   log,
+		cache:        filesLineCache{},
+		maxOpenFiles: maxOpenFiles,
+		readFile:     ioutil.ReadFile,
+		log:          log,
 	}
 }
 
@@ -31,10 +42,12 @@ func (p SourceCode) Name() string {
 }
 
 func (p SourceCode) Process(issues []result.Issue) ([]result.Issue, error) {
+	readErrs := p.readFiles(issues)
+
 	return transformIssues(issues, func(i *result.Issue) *result.Issue {
-		lines, err := p.getFileLinesForIssue(i)
-		if err != nil {
-			p.log.Warnf("Failed to get lines for file %s: %s", i.FilePath(), err)
+		lines, ok := p.cache[i.FilePath()]
+		if !ok {
+			p.log.Warnf("Failed to get lines for file %s: %s", i.FilePath(), readErrs[i.FilePath()])
 			return i
 		}
 
@@ -61,21 +74,54 @@ func (p SourceCode) Process(issues []result.Issue) ([]result.Issue, error) {
 	}), nil
 }
 
-func (p *SourceCode) getFileLinesForIssue(i *result.Issue) (linesCache, error) {
-	fc := p.cache[i.FilePath()]
-	if fc != nil {
-		return fc, nil
+// readFiles caches lines of not yet read files of the issues and returns errors of reading them.
+func (p SourceCode) readFiles(issues []result.Issue) map[string]error {
+	var filePaths []string
+	seen := map[string]bool{}
+	for i := range issues {
+		filePath := issues[i].FilePath()
+		if _, ok := p.cache[filePath]; ok || seen[filePath] {
+			continue
+		}
+		seen[filePath] = true
+		filePaths = append(filePaths, filePath)
+	}
+
+	readErrs := map[string]error{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, p.maxOpenFiles)
+	for _, filePath := range filePaths {
+		filePath := filePath
+		wg.Add(1)
+		sem <- struct{}{} // acquire before starting goroutine to not start them for all files at once
+		go func() {
+			defer wg.Done()
+			lines, err := p.readFileLines(filePath)
+			<-sem
+
+			mu.Lock()
+			if err != nil {
+				readErrs[filePath] = err
+			} else {
+				p.cache[filePath] = lines
+			}
+			mu.Unlock()
+		}()
 	}
+	wg.Wait()
 
+	return readErrs
+}
+
+func (p SourceCode) readFileLines(filePath string) (linesCache, error) {
 	// TODO: make more optimal algorithm: don't load all files into memory
-	fileBytes, err := ioutil.ReadFile(i.FilePath())
+	fileBytes, err := p.readFile(filePath)
 	if err != nil {
-		return nil, fmt.Errorf("can't read file %s for printing issued line: %s", i.FilePath(), err)
+		return nil, fmt.Errorf("can't read file %s for printing issued line: %s", filePath, err)
 	}
-	lines := bytes.Split(fileBytes, []byte("\n")) // TODO: what about \r\n?
-	fc = lines
-	p.cache[i.FilePath()] = fc
-	return fc, nil
+
+	return bytes.Split(fileBytes, []byte("\n")), nil // TODO: what about \r\n?
 }
 
 func (p SourceCode) Finish() {}
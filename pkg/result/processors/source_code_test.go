@@ -0,0 +1,70 @@
+package processors
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type countingFileReader struct {
+	mu        sync.Mutex
+	opened    int
+	maxOpened int
+	reads     map[string]int
+}
+
+func (r *countingFileReader) readFile(filename string) ([]byte, error) {
+	r.mu.Lock()
+	r.opened++
+	r.reads[filename]++
+	if r.opened > r.maxOpened {
+		r.maxOpened = r.opened
+	}
+	r.mu.Unlock()
+
+	time.Sleep(time.Millisecond) // give other goroutines a chance to open files
+
+	r.mu.Lock()
+	r.opened--
+	r.mu.Unlock()
+
+	return []byte("package p\n\nfunc F() {}\n"), nil
+}
+
+func TestSourceCodeMaxOpenFiles(t *testing.T) {
+	var issues []result.Issue
+	for i := 0; i < 50; i++ {
+		for line := 1; line <= 3; line++ {
+			issues = append(issues, newFLIssue(fmt.Sprintf("f%d.go", i), line))
+		}
+	}
+
+	for _, maxOpenFiles := range []int{0, 1, 3, 16} {
+		r := &countingFileReader{reads: map[string]int{}}
+		p := NewSourceCode(maxOpenFiles, logutils.NewStderrLog("test"))
+		p.readFile = r.readFile
+
+		processedIssues, err := p.Process(issues)
+		require.NoError(t, err)
+
+		limit := maxOpenFiles
+		if limit == 0 {
+			limit = 1 // files are opened one by one by default
+		}
+		assert.True(t, r.maxOpened <= limit, "opened %d files at once, limit is %d", r.maxOpened, limit)
+		assert.Len(t, r.reads, 50)
+		for filename, count := range r.reads {
+			assert.Equal(t, 1, count, filename)
+		}
+
+		require.Len(t, processedIssues, len(issues))
+		assert.Equal(t, []string{"func F() {}"}, processedIssues[2].SourceLines)
+	}
+}
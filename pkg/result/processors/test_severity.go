@@ -0,0 +1,42 @@
+package processors
+
+import (
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// TestSeverity overrides severities of issues in test files.
+type TestSeverity struct {
+	severity string
+}
+
+var _ Processor = TestSeverity{}
+
+func NewTestSeverity(severity string) *TestSeverity {
+	return &TestSeverity{
+		severity: severity,
+	}
+}
+
+func (TestSeverity) Name() string {
+	return "test_severity"
+}
+
+func (p TestSeverity) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.severity == "" {
+		return issues, nil
+	}
+
+	return transformIssues(issues, func(i *result.Issue) *result.Issue {
+		if !strings.HasSuffix(i.FilePath(), "_test.go") {
+			return i
+		}
+
+		newI := *i
+		newI.Severity = p.severity
+		return &newI
+	}), nil
+}
+
+func (TestSeverity) Finish() {}
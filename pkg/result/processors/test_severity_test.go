@@ -0,0 +1,35 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newSeverityIssue(filename, severity string) result.Issue {
+	i := newLinterIssue("gosec", filename, 0, 0, "")
+	i.Severity = severity
+	return i
+}
+
+func TestTestSeverity(t *testing.T) {
+	p := NewTestSeverity(result.SeverityWarning)
+
+	issues, err := p.Process([]result.Issue{
+		newSeverityIssue("a_test.go", "high"),
+		newSeverityIssue("a_test.go", ""),
+		newSeverityIssue("a.go", "high"),
+		newSeverityIssue("a.go", ""),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []result.Issue{
+		newSeverityIssue("a_test.go", result.SeverityWarning),
+		newSeverityIssue("a_test.go", result.SeverityWarning),
+		newSeverityIssue("a.go", "high"),
+		newSeverityIssue("a.go", ""),
+	}, issues)
+
+	processAssertSame(t, NewTestSeverity(""), newSeverityIssue("a_test.go", "high"))
+}
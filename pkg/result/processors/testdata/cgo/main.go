@@ -0,0 +1,11 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+
+func main() {
+	p := C.malloc(1)
+	_ = p
+}
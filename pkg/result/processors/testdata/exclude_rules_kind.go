@@ -0,0 +1,13 @@
+package testdata
+
+import "os"
+
+func ExcludeRulesKind() {
+	f, _ := os.Open("a")
+	defer f.Close()
+	f.Close()
+	go f.Close()
+	if err := f.Close(); err != nil {
+		defer f.Close()
+	}
+}
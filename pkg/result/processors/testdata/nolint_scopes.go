@@ -0,0 +1,14 @@
+//nolint:golint,govet
+package testdata
+
+import "fmt"
+
+//nolint:unparam
+func nolintScopes() {
+	fmt.Println("line") //nolint:errcheck
+	fmt.Println("func")
+}
+
+func nolintScopesFile() {
+	fmt.Println("file")
+}
@@ -0,0 +1,8 @@
+//nolint
+package testdata
+
+import "fmt"
+
+func nolintScopesBare() {
+	fmt.Println("line") //nolint:errcheck
+}
@@ -2,6 +2,7 @@ package processors
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"github.com/golangci/golangci-lint/pkg/result"
 )
@@ -47,3 +48,25 @@ func transformIssues(issues []result.Issue, transform func(i *result.Issue) *res
 
 	return retIssues
 }
+
+func transformIssuesErr(issues []result.Issue, transform func(i *result.Issue) (*result.Issue, error)) ([]result.Issue, error) {
+	retIssues := make([]result.Issue, 0, len(issues))
+	for _, i := range issues {
+		i := i
+		newI, err := transform(&i)
+		if err != nil {
+			return nil, fmt.Errorf("can't transform issue %#v: %s", i, err)
+		}
+
+		if newI != nil {
+			retIssues = append(retIssues, *newI)
+		}
+	}
+
+	return retIssues, nil
+}
+
+// isGoFile checks the issue is in a Go file: processors parsing files skip issues of other files.
+func isGoFile(i *result.Issue) bool {
+	return filepath.Ext(i.FilePath()) == ".go"
+}
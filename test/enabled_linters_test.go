@@ -2,10 +2,13 @@ package test
 
 import (
 	"fmt"
+	"runtime"
 	"sort"
 	"strings"
 	"testing"
 
+	"github.com/golangci/golangci-lint/pkg/goutil"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
 	"github.com/golangci/golangci-lint/test/testshared"
 )
@@ -37,11 +40,26 @@ func getEnabledByDefaultFastLintersExcept(except ...string) []string {
 	return ret
 }
 
+// isObsoleteForToolchain checks the linter is disabled as obsolete: go.mod has no go directive
+// and the version of the running toolchain is targeted.
+func isObsoleteForToolchain(lc *linter.Config) bool {
+	if lc.ObsoleteSinceGo == "" {
+		return false
+	}
+
+	targetMinor, err := goutil.ParseMinorVersion(runtime.Version())
+	if err != nil {
+		return false
+	}
+	obsoleteMinor, err := goutil.ParseMinorVersion(lc.ObsoleteSinceGo)
+	return err == nil && targetMinor >= obsoleteMinor
+}
+
 func getAllFastLintersWith(with ...string) []string {
 	linters := lintersdb.NewManager().GetAllSupportedLinterConfigs()
 	ret := append([]string{}, with...)
 	for _, lc := range linters {
-		if lc.NeedsSSARepr {
+		if lc.NeedsSSARepr || isObsoleteForToolchain(lc) {
 			continue
 		}
 		ret = append(ret, lc.Name())
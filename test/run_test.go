@@ -1,14 +1,23 @@
 package test
 
 import (
+	"bufio"
+	"encoding/json"
+	"go/token"
+	"io/ioutil"
+	"net"
+	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/golangci/golangci-lint/test/testshared"
 
 	"github.com/golangci/golangci-lint/pkg/exitcodes"
+	"github.com/golangci/golangci-lint/pkg/printers"
+	"github.com/golangci/golangci-lint/pkg/result"
 )
 
 func getCommonRunArgs() []string {
@@ -50,6 +59,175 @@ func TestTestsAreLintedByDefault(t *testing.T) {
 		ExpectHasIssue("if block ends with a return")
 }
 
+func TestDryRun(t *testing.T) {
+	testshared.NewLintRunner(t).Run("--no-config", "--disable-all", "-Egolint", "-Egovet", "--dry-run", getTestDataDir("withtests")).
+		ExpectExitCode(exitcodes.Success).
+		ExpectOutputContains("testdata/withtests [").
+		ExpectOutputContains("testdata/withtests/p_test.go").
+		ExpectOutputContains("Linters (2):\n  golint\n  govet\n")
+}
+
+func TestBestEffortAST(t *testing.T) {
+	testshared.NewLintRunner(t).Run("--print-issued-lines=false", "--no-config", "--disable-all",
+		"-Elll", "-Egofmt", "-Egochecknoinits", "--best-effort-ast", getTestDataDir("syntax_error")).
+		ExpectExitCode(exitcodes.IssuesFound).
+		ExpectOutputContains("testdata/syntax_error/syntax_error.go:5:2: expected ';', found 'return' (typecheck)").
+		ExpectOutputContains("testdata/syntax_error/syntax_error.go:9: line is 125 characters (lll)").
+		ExpectOutputContains("testdata/syntax_error/syntax_error.go:12:1: don't use `init` function (gochecknoinits)")
+}
+
+func TestStatusJSON(t *testing.T) {
+	testshared.NewLintRunner(t).Run("--print-issued-lines=false", "--no-config", "--disable-all",
+		"-Elll", "--best-effort-ast", "--status-json", getTestDataDir("syntax_error")).
+		ExpectExitCode(exitcodes.IssuesFound).
+		ExpectOutputContains(`{"issue_count":2,"exit_code":1,"duration_ms":`).
+		ExpectOutputContains(`"linters_run":["lll"]}`)
+}
+
+func TestIssuesSocket(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "issues-socket")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	socketPath := filepath.Join(tmpDir, "gcl.sock")
+	l, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	defer l.Close()
+
+	received := make(chan []result.Issue, 1)
+	go func() {
+		var issues []result.Issue
+		defer func() { received <- issues }()
+
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		scanner := bufio.NewScanner(conn)
+		for scanner.Scan() {
+			var i result.Issue
+			if json.Unmarshal(scanner.Bytes(), &i) == nil {
+				issues = append(issues, i)
+			}
+		}
+	}()
+
+	testshared.NewLintRunner(t).Run("--print-issued-lines=false", "--no-config", "--disable-all",
+		"-Elll", "--best-effort-ast", "--issues-socket", "unix:"+socketPath, getTestDataDir("syntax_error")).
+		ExpectExitCode(exitcodes.IssuesFound)
+
+	var linters []string
+	for _, i := range <-received {
+		linters = append(linters, i.FromLinter)
+	}
+	assert.ElementsMatch(t, []string{"typecheck", "lll"}, linters)
+}
+
+func TestIssuesSocketConnectionErrorIsNotFatal(t *testing.T) {
+	testshared.NewLintRunner(t).Run("--print-issued-lines=false", "--no-config", "--disable-all",
+		"-Elll", "--best-effort-ast", "--issues-socket", "unix:/nonexistent/gcl.sock", getTestDataDir("syntax_error")).
+		ExpectExitCode(exitcodes.IssuesFound).
+		ExpectOutputContains("Can't connect to issues socket")
+}
+
+func TestMultipleOutFormats(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "out-formats")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	jsonPath := filepath.Join(tmpDir, "report.json")
+	testshared.NewLintRunner(t).Run("--print-issued-lines=false", "--no-config", "--disable-all", "-Elll",
+		"--best-effort-ast", "--out-format", "json:"+jsonPath+",line-number", getTestDataDir("syntax_error")).
+		ExpectExitCode(exitcodes.IssuesFound).
+		ExpectOutputContains("(lll)")
+
+	data, err := ioutil.ReadFile(jsonPath)
+	require.NoError(t, err)
+
+	var report struct {
+		Issues []result.Issue
+	}
+	require.NoError(t, json.Unmarshal(data, &report))
+	assert.Len(t, report.Issues, 2)
+}
+
+func TestIssuesIDsAreStable(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "issues-ids")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	runIDs := func() []string {
+		jsonPath := filepath.Join(tmpDir, "report.json")
+		testshared.NewLintRunner(t).Run("--no-config", "--disable-all", "-Elll", "--best-effort-ast",
+			"--out-format", "json:"+jsonPath, getTestDataDir("syntax_error")).
+			ExpectExitCode(exitcodes.IssuesFound)
+
+		data, err := ioutil.ReadFile(jsonPath)
+		require.NoError(t, err)
+
+		var report struct {
+			Issues []result.Issue
+		}
+		require.NoError(t, json.Unmarshal(data, &report))
+
+		var ids []string
+		for _, i := range report.Issues {
+			ids = append(ids, i.ID)
+		}
+		return ids
+	}
+
+	ids := runIDs()
+	require.Len(t, ids, 2)
+	assert.NotEmpty(t, ids[0])
+	assert.NotEqual(t, ids[0], ids[1], "different issues must have different IDs")
+	assert.ElementsMatch(t, ids, runIDs(), "IDs must be the same in runs with unchanged code")
+}
+
+func TestExitCodesBySeverity(t *testing.T) {
+	onlyWarnings := getTestDataDir("severity", "severity_test.go")
+	withErrors := getTestDataDir("severity")
+	args := []string{"--no-config", "--disable-all", "-Elll", "--test-severity=warning"}
+	r := testshared.NewLintRunner(t)
+
+	r.Run(append(args, onlyWarnings)...).ExpectExitCode(exitcodes.Success)
+	r.Run(append(args, withErrors)...).ExpectExitCode(exitcodes.IssuesFound)
+
+	args = append(args, "--exit-code-on-warning=5", "--exit-code-on-error=6")
+	r.Run(append(args, onlyWarnings)...).ExpectExitCode(5)
+	r.Run(append(args, withErrors)...).ExpectExitCode(6)
+}
+
+func TestMergeReport(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "merge-report")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	external, err := json.Marshal(printers.JSONResult{Issues: []result.Issue{{
+		FromLinter: "licensecheck",
+		Text:       "missing license header",
+		Pos:        token.Position{Filename: getTestDataDir("lll.go"), Line: 1},
+	}}})
+	require.NoError(t, err)
+	externalPath := filepath.Join(tmpDir, "external.json")
+	require.NoError(t, ioutil.WriteFile(externalPath, external, os.ModePerm))
+
+	testshared.NewLintRunner(t).Run("--print-issued-lines=false", "--no-config", "--disable-all", "-Elll",
+		"--merge-report", externalPath, getTestDataDir("lll.go")).
+		ExpectExitCode(exitcodes.IssuesFound).
+		ExpectOutputContains("testdata/lll.go:1: missing license header (licensecheck)").
+		ExpectOutputContains("(lll)")
+}
+
+func TestMergeReportNotExisting(t *testing.T) {
+	testshared.NewLintRunner(t).Run("--no-config", "--disable-all", "-Elll",
+		"--merge-report", filepath.Join(os.TempDir(), "not-existing-report.json"), getTestDataDir("lll.go")).
+		ExpectExitCode(exitcodes.Failure).
+		ExpectOutputContains("can't read merged report")
+}
+
 func TestCgoOk(t *testing.T) {
 	testshared.NewLintRunner(t).Run("--enable-all", getTestDataDir("cgo")).ExpectNoIssues()
 }
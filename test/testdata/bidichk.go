@@ -0,0 +1,11 @@
+//args: -Ebidichk
+package testdata
+
+import "fmt"
+
+func BidichkTrojanSource() {
+	accessLevel := "user"
+	if accessLevel != "user‮ // Check if admin" { // ERROR "found dangerous unicode character sequence RIGHT-TO-LEFT-OVERRIDE"
+		fmt.Println("You are an admin.")
+	}
+}
@@ -0,0 +1,8 @@
+//args: -Ebidichk
+package testdata
+
+import "fmt"
+
+func BidichkClean() {
+	fmt.Println("שלום, world") // right-to-left text without control characters
+}
@@ -0,0 +1,22 @@
+//args: -Econtainedctx
+package testdata
+
+import "context"
+
+type containedctxStruct struct {
+	ctx  context.Context // ERROR "found a struct that contains a context.Context field `ctx`"
+	name string
+}
+
+type containedctxEmbedded struct {
+	context.Context // ERROR "found a struct that contains a context.Context field embedded"
+}
+
+type containedctxOk struct {
+	name string
+}
+
+func (s containedctxOk) Run(ctx context.Context) error {
+	_ = struct{ parent context.Context }{ctx} // ERROR "found a struct that contains a context.Context field `parent`"
+	return ctx.Err()
+}
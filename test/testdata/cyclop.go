@@ -0,0 +1,20 @@
+//args: -Ecyclop
+//config: linters-settings.cyclop.max-complexity=15
+//config: linters-settings.cyclop.package-average=2.5
+package testdata // ERROR "the average complexity for the package `testdata` is 3.00, max is 2.50"
+
+func cyclopSimple(a int) int {
+	return a
+}
+
+func cyclopBranches(a, b int) int {
+	if a > b && b > 0 {
+		return a
+	}
+	for i := 0; i < b; i++ {
+		if i%2 == 0 {
+			a++
+		}
+	}
+	return b
+}
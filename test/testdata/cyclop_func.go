@@ -0,0 +1,19 @@
+//args: -Ecyclop
+//config: linters-settings.cyclop.max-complexity=3
+package testdata
+
+func cyclopFuncSimple(a int) int {
+	return a
+}
+
+func cyclopFuncBranches(a, b int) int { // ERROR "calculated cyclomatic complexity for function `cyclopFuncBranches` is 5, max is 3"
+	if a > b && b > 0 {
+		return a
+	}
+	for i := 0; i < b; i++ {
+		if i%2 == 0 {
+			a++
+		}
+	}
+	return b
+}
@@ -0,0 +1,20 @@
+//args: -Ecyclop
+//config: linters-settings.cyclop.max-complexity=5
+//config: linters-settings.cyclop.package-average=5
+package testdata
+
+func cyclopUnderSimple(a int) int {
+	return a
+}
+
+func cyclopUnderBranches(a, b int) int {
+	if a > b && b > 0 {
+		return a
+	}
+	for i := 0; i < b; i++ {
+		if i%2 == 0 {
+			a++
+		}
+	}
+	return b
+}
@@ -0,0 +1,15 @@
+//args: -Edecorder
+//config: linters-settings.decorder.single-const=true
+package testdata
+
+const decorderFirst = 1
+
+const decorderSecond = 2 // ERROR "multiple `const` declarations are not allowed; use parentheses instead"
+
+func decorderFunc() int {
+	return decorderFirst + decorderSecond
+}
+
+type decorderType struct{} // ERROR "`type` must not be placed after `func` \(desired order: type,const,var,func\)"
+
+var decorderVar = decorderType{} // ERROR "`var` must not be placed after `func`"
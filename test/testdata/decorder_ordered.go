@@ -0,0 +1,22 @@
+//args: -Edecorder
+//config: linters-settings.decorder.dec-order=type,const,var,func
+package testdata
+
+import "fmt"
+
+type decorderOrderedType struct{}
+
+type decorderOrderedType2 struct{}
+
+const (
+	decorderOrderedConst  = 1
+	decorderOrderedConst2 = 2
+)
+
+var decorderOrderedVar = decorderOrderedType{}
+
+var decorderOrderedVar2 = decorderOrderedType2{}
+
+func decorderOrderedFunc() {
+	fmt.Println(decorderOrderedConst, decorderOrderedConst2, decorderOrderedVar, decorderOrderedVar2)
+}
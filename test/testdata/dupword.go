@@ -0,0 +1,13 @@
+//args: -Edupword
+package testdata
+
+// Sum returns the the sum of a and b. // ERROR "Duplicate words \(the\) found"
+func Sum(a, b int) int {
+	/* It's is is a block comment. */ // ERROR "Duplicate words \(is\) found"
+	return a + b
+}
+
+// Diff returns the difference of a and b: the result can be negative. Hello, hello.
+func Diff(a, b int) int {
+	return a - b
+}
@@ -0,0 +1,64 @@
+//args: -Eerrorlint
+package testdata
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+var errErrorlintNotFound = errors.New("not found")
+
+func errorlintDo() error {
+	return fmt.Errorf("do: %w", errErrorlintNotFound)
+}
+
+func ErrorlintComparison() bool {
+	err := errorlintDo()
+	return err == errErrorlintNotFound // ERROR "comparing with == will fail on wrapped errors"
+}
+
+func ErrorlintIs() bool {
+	err := errorlintDo()
+	return errors.Is(err, errErrorlintNotFound)
+}
+
+func ErrorlintNilComparison() bool {
+	return errorlintDo() != nil
+}
+
+func ErrorlintSwitch() string {
+	switch errorlintDo() { // ERROR "switch on an error will fail on wrapped errors"
+	case errErrorlintNotFound:
+		return "not found"
+	}
+	return ""
+}
+
+func ErrorlintAssert() bool {
+	_, ok := errorlintDo().(*os.PathError) // ERROR "type assertion on error will fail on wrapped errors"
+	return ok
+}
+
+func ErrorlintTypeSwitch() bool {
+	switch errorlintDo().(type) { // ERROR "type switch on error will fail on wrapped errors"
+	case *os.PathError:
+		return true
+	}
+	return false
+}
+
+func ErrorlintAs() bool {
+	var pathErr *os.PathError
+	return errors.As(errorlintDo(), &pathErr)
+}
+
+func ErrorlintErrorf() error {
+	err := errorlintDo()
+	return fmt.Errorf("failed %d times: %v", 1, err) // ERROR "non-wrapping format verb for fmt.Errorf. Use `%w` to format errors"
+}
+
+func ErrorlintErrorfWrapping() error {
+	err := errorlintDo()
+	return fmt.Errorf("failed %d%% times: %w", 1, err)
+}
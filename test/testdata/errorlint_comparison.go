@@ -0,0 +1,19 @@
+//args: -Eerrorlint
+//config: linters-settings.errorlint.comparison=false
+package testdata
+
+import (
+	"errors"
+	"os"
+)
+
+var errErrorlintComparisonNotFound = errors.New("not found")
+
+func ErrorlintComparisonDisabled(err error) bool {
+	return err == errErrorlintComparisonNotFound
+}
+
+func ErrorlintComparisonDisabledAssert(err error) bool {
+	_, ok := err.(*os.PathError) // ERROR "type assertion on error will fail on wrapped errors"
+	return ok
+}
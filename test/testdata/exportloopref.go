@@ -0,0 +1,39 @@
+//args: -Eexportloopref
+//config: run.go=1.21
+package testdata
+
+var exportlooprefGlobal *int
+
+func ExportlooprefAppend() []*int {
+	var s []*int
+	for i := 0; i < 3; i++ {
+		s = append(s, &i) // ERROR "exporting a pointer for the loop variable i"
+	}
+	return s
+}
+
+func ExportlooprefAssign(values []int) {
+	var last *int
+	for k, v := range values {
+		last = &v                // ERROR "exporting a pointer for the loop variable v"
+		exportlooprefGlobal = &k // ERROR "exporting a pointer for the loop variable k"
+	}
+	_ = last
+}
+
+func ExportlooprefCopied(values []int) []*int {
+	var s []*int
+	for _, v := range values {
+		v := v
+		s = append(s, &v)
+	}
+	return s
+}
+
+func ExportlooprefInsideIteration(values []int) {
+	for _, v := range values {
+		var p *int
+		p = &v
+		_ = p
+	}
+}
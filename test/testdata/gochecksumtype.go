@@ -0,0 +1,50 @@
+//args: -Egochecksumtype
+package testdata
+
+//sumtype:decl
+type Shape interface {
+	sealed()
+}
+
+type Circle struct{ R float64 }
+
+func (Circle) sealed() {}
+
+type Square struct{ Side float64 }
+
+func (*Square) sealed() {}
+
+type Triangle struct{ A, B, C float64 }
+
+func (Triangle) sealed() {}
+
+func Area(s Shape) float64 {
+	switch s := s.(type) { // ERROR "exhaustiveness check failed for sum type Shape: missing cases for Triangle"
+	case Circle:
+		return 3.14 * s.R * s.R
+	case *Square:
+		return s.Side * s.Side
+	}
+	return 0
+}
+
+func Name(s Shape) string {
+	switch s.(type) {
+	case Circle:
+		return "circle"
+	case *Square:
+		return "square"
+	case Triangle:
+		return "triangle"
+	}
+	return ""
+}
+
+func IsRound(s Shape) bool {
+	switch s.(type) {
+	case Circle:
+		return true
+	default:
+		return false
+	}
+}
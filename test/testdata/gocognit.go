@@ -0,0 +1,42 @@
+//args: -Egocognit
+//config: linters-settings.gocognit.min-complexity=5
+package testdata
+
+func gocognitNested(items [][]int, skip bool) int { // ERROR "cognitive complexity 10 of func .gocognitNested. is high .> 5."
+	sum := 0
+	for _, row := range items { // +1
+		if skip && len(row) == 0 { // +2 (nesting = 1), +1 for &&
+			continue
+		}
+		for _, v := range row { // +2 (nesting = 1)
+			if v > 0 { // +3 (nesting = 2)
+				sum += v
+			} else { // +1
+				sum--
+			}
+		}
+	}
+	return sum
+}
+
+func gocognitFlat(a, b int) int {
+	if a > b { // +1
+		return a
+	}
+	switch { // +1
+	case a == 0:
+		return 0
+	case b == 0:
+		return 1
+	}
+	return b
+}
+
+func gocognitRecursive(n int) int { // ERROR "cognitive complexity 6 of func .gocognitRecursive. is high .> 5."
+	if n <= 1 || n > 100 { // +1, +1 for ||
+		return n
+	} else if n%2 == 0 && n > 10 || n == 4 { // +1, +2 for && and ||
+		return gocognitRecursive(n / 2) // +1
+	}
+	return n
+}
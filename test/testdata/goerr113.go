@@ -0,0 +1,41 @@
+//args: -Egoerr113
+package testdata
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrGoerr113NotFound = errors.New("not found")
+
+func Goerr113Dynamic() error {
+	return errors.New("something went wrong") // ERROR "do not define dynamic errors, use wrapped static errors instead: `errors.New`"
+}
+
+func Goerr113DynamicErrorf(id int) (int, error) {
+	return 0, fmt.Errorf("no item %d", id) // ERROR "do not define dynamic errors, use wrapped static errors instead: `fmt.Errorf`"
+}
+
+func Goerr113Sentinel() error {
+	return ErrGoerr113NotFound
+}
+
+func Goerr113Wrapped(id int) error {
+	return fmt.Errorf("item %d: %w", id, ErrGoerr113NotFound)
+}
+
+func Goerr113Comparison(err error) bool {
+	return err == ErrGoerr113NotFound // ERROR "do not compare errors directly `err == ErrGoerr113NotFound`, use `errors.Is.err, ErrGoerr113NotFound.` instead"
+}
+
+func Goerr113NilComparison(err error) bool {
+	return err != nil
+}
+
+type goerr113Error struct{}
+
+func (goerr113Error) Error() string { return "goerr113" }
+
+func (goerr113Error) Is(target error) bool {
+	return target == ErrGoerr113NotFound
+}
@@ -0,0 +1,27 @@
+//args: -Egosmopolitan
+package testdata
+
+import (
+	"fmt"
+	"time"
+)
+
+type GosmopolitanTagged struct {
+	Name string `json:"名前"`
+}
+
+func GosmopolitanCJK() {
+	fmt.Println("你好，世界") // ERROR "string literal contains rune in Han script"
+}
+
+func GosmopolitanASCII() {
+	fmt.Println("Hello, world")
+}
+
+func GosmopolitanOtherScript() {
+	fmt.Println("Привет, мир")
+}
+
+func GosmopolitanTimeLocal() time.Time {
+	return time.Now().In(time.Local) // ERROR "usage of `time.Local`"
+}
@@ -0,0 +1,21 @@
+//args: -Egosmopolitan
+//config: linters-settings.gosmopolitan.watch-for-scripts=Cyrillic
+//config: linters-settings.gosmopolitan.allow-time-local=true
+package testdata
+
+import (
+	"fmt"
+	"time"
+)
+
+func GosmopolitanScriptsCJK() {
+	fmt.Println("你好，世界")
+}
+
+func GosmopolitanScriptsCyrillic() {
+	fmt.Println("Привет, мир") // ERROR "string literal contains rune in Cyrillic script"
+}
+
+func GosmopolitanScriptsTimeLocal() time.Time {
+	return time.Now().In(time.Local)
+}
@@ -0,0 +1,25 @@
+//args: -Eimportas
+//config_path: testdata/configs/importas.yml
+package testdata
+
+import (
+	"encoding/json"
+	htmltemplate "html/template"
+	"strings"           // ERROR "import `strings` imported without alias but must be with alias `str` according to config"
+	tpl "text/template" // ERROR "import `text/template` imported as `tpl` but must be `texttemplate` according to config"
+)
+
+func Render(data interface{}) (string, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	t := tpl.Must(tpl.New("data").Parse("{{.}}"))
+	if err := t.Execute(&sb, string(b)); err != nil {
+		return "", err
+	}
+
+	return htmltemplate.HTMLEscapeString(sb.String()), nil
+}
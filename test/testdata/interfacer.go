@@ -1,8 +1,22 @@
 //args: -Einterfacer
 package testdata
 
-import "io"
+import (
+	"io"
+	"os"
+)
 
 func InterfacerCheck(f io.ReadCloser) { // ERROR "`f` can be `io.Closer`"
 	f.Close()
 }
+
+func InterfacerConcreteRead(f *os.File, p []byte) (int, error) { // ERROR "`f` can be `io.Reader`"
+	return f.Read(p)
+}
+
+func InterfacerConcreteReadStat(f *os.File, p []byte) (int, error) {
+	if _, err := f.Stat(); err != nil {
+		return 0, err
+	}
+	return f.Read(p)
+}
@@ -0,0 +1,27 @@
+//args: -Eloggercheck
+package testdata
+
+import (
+	"context"
+	"log/slog"
+)
+
+func LoggercheckOddArgs(ctx context.Context, logger *slog.Logger) {
+	slog.Info("user logged in", "id", 1, "name")         // ERROR "odd number of arguments passed as key-value pairs for logging to `Info`"
+	logger.ErrorContext(ctx, "request failed", "status") // ERROR "odd number of arguments passed as key-value pairs for logging to `ErrorContext`"
+	logger.With("request", 1, "user").Info("started")    // ERROR "odd number of arguments passed as key-value pairs for logging to `With`"
+}
+
+func LoggercheckNonStringKeys(logger *slog.Logger) {
+	slog.Warn("slow request", 42, "ms")          // ERROR "logging keys must be strings, got `int`"
+	logger.Info("retry", "attempt", 1, 2.5, "x") // ERROR "logging keys must be strings, got `float64`"
+}
+
+func LoggercheckBalanced(ctx context.Context, logger *slog.Logger, key string, kv []interface{}) {
+	slog.Info("user logged in", "id", 1, "name", "gopher")
+	slog.Info("no pairs")
+	logger.InfoContext(ctx, "request", slog.Int("status", 200), "path", "/", slog.String("method", "GET"))
+	logger.Log(ctx, slog.LevelDebug, "dynamic key", key, 1)
+	logger.Info("passed by slice", kv...)
+	slog.Group("request", "id", 1)
+}
@@ -0,0 +1,52 @@
+//args: -Emaintidx
+//config: linters-settings.maintidx.under=45
+package testdata
+
+func MaintidxSimple(a, b int) int {
+	return a + b
+}
+
+func MaintidxComplex(s string, n int) int { // ERROR "maintainability index \d+ of func `MaintidxComplex` is low \(< 45\)"
+	res := 0
+	for i := 0; i < n; i++ {
+		switch {
+		case s == "a" || s == "b":
+			res += i * 2
+		case s == "c" && i%2 == 0:
+			res -= i / 3
+		case len(s) > 10:
+			res *= 5
+		default:
+			res++
+		}
+
+		if res > 1000 {
+			res = res % 1000
+		} else if res < -1000 {
+			res = -(res % 1000)
+		}
+
+		for j, c := range s {
+			if c == 'x' || c == 'y' {
+				res += j
+			} else if c == 'z' && j > 3 {
+				res -= j * i
+			}
+		}
+	}
+
+	if n > 100 && res%7 == 0 {
+		res = res/7 + n
+	}
+
+	if s == "" || n == 0 {
+		return -1
+	}
+
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	if v, ok := m[s]; ok && v > 1 {
+		res += v * n
+	}
+
+	return res
+}
@@ -0,0 +1,29 @@
+//args: -Emnd
+package testdata
+
+import "time"
+
+const mndTimeout = 5 * time.Second
+
+func mndSleep(n int) int {
+	time.Sleep(3 * time.Second) // ERROR "Magic number: 3, in <operation> detected"
+	time.Sleep(mndTimeout)
+
+	retries := 10 // ERROR "Magic number: 10, in <assign> detected"
+	if n > 100 {  // ERROR "Magic number: 100, in <condition> detected"
+		return retries
+	}
+
+	switch n {
+	case 42: // ERROR "Magic number: 42, in <case> detected"
+		return 0
+	case -7: // ERROR "Magic number: -7, in <case> detected"
+		return 1
+	}
+
+	var values [4]int
+	values[2] = n // index isn't checked by default
+	_ = mndSleep(n - 1)
+
+	return values[0] * 25 // ERROR "Magic number: 25, in <operation> detected"
+}
@@ -0,0 +1,31 @@
+//args: -Emusttag
+package testdata
+
+import (
+	"encoding/json"
+)
+
+type MusttagUser struct {
+	Name  string `json:"name"`
+	Email string
+	age   int
+}
+
+func MusttagUntagged() ([]byte, error) {
+	return json.Marshal(MusttagUser{}) // ERROR "the field `MusttagUser.Email` should be annotated with the `json` tag as it is passed to `encoding/json.Marshal`"
+}
+
+type MusttagTagged struct {
+	Name    string            `json:"name"`
+	Tags    []string          `json:"tags"`
+	Labels  map[string]string `json:"labels"`
+	private int
+}
+
+func MusttagAllTagged() ([]byte, error) {
+	return json.Marshal(&MusttagTagged{})
+}
+
+func MusttagNotSerialized() MusttagUser {
+	return MusttagUser{}
+}
@@ -0,0 +1,35 @@
+//args: -Enilnil
+package testdata
+
+import "io"
+
+type nilnilUser struct {
+	name string
+}
+
+func NilnilPointer(name string) (*nilnilUser, error) {
+	if name == "" {
+		return nil, nil // ERROR "return both the `nil` error and invalid value: use a sentinel error instead"
+	}
+	u := nilnilUser{name: name}
+	return &u, nil
+}
+
+func NilnilInterface() (io.Reader, error) {
+	return nil, nil // ERROR "return both the `nil` error and invalid value: use a sentinel error instead"
+}
+
+func NilnilNamedResults() (r io.Reader, err error) {
+	return nil, nil // ERROR "return both the `nil` error and invalid value: use a sentinel error instead"
+}
+
+func NilnilValue() (int, error) {
+	return 0, nil
+}
+
+func NilnilNestedFunc() (*nilnilUser, error) {
+	f := func() error {
+		return nil
+	}
+	return &nilnilUser{}, f()
+}
@@ -0,0 +1,10 @@
+//args: -Enolintlint
+//config: linters-settings.nolintlint.require-specific=true
+package testdata
+
+import "fmt"
+
+func Nolintlint() {
+	fmt.Println("bare") //nolint // ERROR "directive `//nolint // ERROR .*` should mention specific linter such as `//nolint:my-linter`"
+	fmt.Println("specific") //nolint:errcheck // it's just an example
+}
@@ -0,0 +1,44 @@
+//args: -Eparalleltest
+package testdata
+
+import "testing"
+
+func TestParalleltestSubtestNotParallel(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sub", func(t *testing.T) { // ERROR "Function TestParalleltestSubtestNotParallel missing the call to method parallel in the test run"
+		_ = t
+	})
+}
+
+func TestParalleltestAllParallel(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"a", "b"} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+		})
+	}
+}
+
+func TestParalleltestNotParallel(t *testing.T) { // ERROR "Function TestParalleltestNotParallel missing the call to method parallel"
+	t.Run("sub", func(t *testing.T) {
+		_ = t
+	})
+}
+
+func TestParalleltestNested(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sub", func(st *testing.T) {
+		st.Parallel()
+
+		st.Run("nested", func(t *testing.T) { // ERROR "Function TestParalleltestNested missing the call to method parallel in the test run"
+			_ = t
+		})
+	})
+}
+
+func paralleltestHelper(t *testing.T) {
+	_ = t
+}
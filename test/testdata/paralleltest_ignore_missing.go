@@ -0,0 +1,19 @@
+//args: -Eparalleltest
+//config: linters-settings.paralleltest.ignore-missing=true
+package testdata
+
+import "testing"
+
+func TestParalleltestIgnoreMissing(t *testing.T) {
+	t.Run("sub", func(t *testing.T) {
+		_ = t
+	})
+}
+
+func TestParalleltestIgnoreMissingSubtest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sub", func(t *testing.T) { // ERROR "Function TestParalleltestIgnoreMissingSubtest missing the call to method parallel in the test run"
+		_ = t
+	})
+}
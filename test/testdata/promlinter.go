@@ -0,0 +1,35 @@
+//args: -Epromlinter
+package testdata
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promRequestDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name: "http_request_duration_seconds",
+		Help: "Duration of HTTP requests.",
+	})
+	promRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "http",
+		Name:      "requests_total",
+		Help:      "Count of HTTP requests.",
+	}, []string{"code"})
+
+	promQueueSize = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "queue_size", // ERROR "metric name .queue_size. should have a unit suffix such as seconds, bytes, bits"
+		Help: "Size of the queue.",
+	})
+	promErrors = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "errors", // ERROR "counter metric .errors. should have ._total. suffix"
+		Help: "Count of errors.",
+	})
+	promCamelCase = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "cacheSizeBytes", // ERROR "metric name .cacheSizeBytes. should be snake_case"
+		Help: "",               // ERROR "metric .cacheSizeBytes. should have non-empty help text"
+	})
+	promNoHelp = prometheus.NewSummary(prometheus.SummaryOpts{ // ERROR "metric .response_size_bytes. should have help text"
+		Name: "response_size_bytes",
+	})
+)
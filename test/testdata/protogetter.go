@@ -0,0 +1,46 @@
+//args: -Eprotogetter
+package testdata
+
+// User is a message like ones generated by protoc-gen-go.
+type User struct {
+	Name    string
+	Age     int32
+	Manager *User
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *User) GetManager() *User {
+	if x != nil {
+		return x.Manager
+	}
+	return nil
+}
+
+func ManagerName(u *User) string {
+	return u.Manager.Name // ERROR "avoid direct access to proto field `u.Manager.Name`, use `u.GetManager\(\).GetName\(\)` instead"
+}
+
+func Greeting(u *User) string {
+	return "Hello, " + u.GetName()
+}
+
+func Birthday(u *User) {
+	u.Age++
+	u.Name = "happy " + u.GetName()
+	_ = &u.Manager
+}
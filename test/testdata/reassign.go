@@ -0,0 +1,26 @@
+//args: -Ereassign
+package testdata
+
+import (
+	"errors"
+	"io"
+	"net/http"
+)
+
+func ReassignEOF() {
+	io.EOF = errors.New("reassigned") // ERROR "reassigning variable EOF in other package io"
+}
+
+func ReassignNotMatchingPattern() {
+	http.DefaultClient = nil
+}
+
+type reassignErrs struct {
+	EOF error
+}
+
+func ReassignLocal() error {
+	var io reassignErrs
+	io.EOF = errors.New("local")
+	return io.EOF
+}
@@ -0,0 +1,17 @@
+//args: -Ereassign
+//config: linters-settings.reassign.patterns=DefaultClient
+package testdata
+
+import (
+	"errors"
+	"io"
+	"net/http"
+)
+
+func ReassignPatternsEOF() {
+	io.EOF = errors.New("reassigned")
+}
+
+func ReassignPatternsDefaultClient() {
+	http.DefaultClient = nil // ERROR "reassigning variable DefaultClient in other package http"
+}
@@ -1,4 +1,5 @@
 //args: -Escopelint
+//config: run.go=1.21
 package testdata
 
 import "fmt"
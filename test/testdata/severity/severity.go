@@ -0,0 +1,4 @@
+package severity
+
+// Description is too long for lll: it's used to test exit codes by severities of issues, the line has more than 120 characters.
+const Description = "severity"
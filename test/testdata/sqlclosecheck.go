@@ -0,0 +1,59 @@
+//args: -Esqlclosecheck
+package testdata
+
+import "database/sql"
+
+func sqlclosecheckUnclosedRows(db *sql.DB) error {
+	rows, err := db.Query("SELECT name FROM users") // ERROR "sql.Rows must be closed by Close"
+	if err != nil {
+		return err
+	}
+
+	for rows.Next() {
+	}
+	return nil
+}
+
+func sqlclosecheckDeferredClose(db *sql.DB) error {
+	rows, err := db.Query("SELECT name FROM users")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+	}
+	return rows.Err()
+}
+
+func sqlclosecheckNotClosedOnAllPaths(db *sql.DB, skip bool) error {
+	rows, err := db.Query("SELECT name FROM users") // ERROR "sql.Rows must be closed by Close"
+	if err != nil {
+		return err
+	}
+	if skip {
+		return nil
+	}
+
+	return rows.Close()
+}
+
+func sqlclosecheckUnclosedStmt(db *sql.DB) error {
+	stmt, err := db.Prepare("SELECT name FROM users WHERE id = ?") // ERROR "sql.Stmt must be closed by Close"
+	if err != nil {
+		return err
+	}
+
+	_, err = stmt.Exec(1)
+	return err
+}
+
+func sqlclosecheckRowScan(db *sql.DB) (string, error) {
+	var name string
+	err := db.QueryRow("SELECT name FROM users WHERE id = ?", 1).Scan(&name)
+	return name, err
+}
+
+func sqlclosecheckReturnedRows(db *sql.DB) (*sql.Rows, error) {
+	return db.Query("SELECT name FROM users")
+}
@@ -0,0 +1,14 @@
+package testdata
+
+func SyntaxError() {
+	x := 1 +
+	return
+}
+
+func LongLine() {
+	println("this line is longer than the default limit of one hundred and twenty characters, so lll must report it, really!!!")
+}
+
+func init() {
+	println("analyzed by AST linters even though the file has a syntax error")
+}
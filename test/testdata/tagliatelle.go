@@ -0,0 +1,13 @@
+//args: -Etagliatelle
+//config: linters-settings.tagliatelle.rules.yaml=snake
+package testdata
+
+type TagliatelleStruct struct {
+	SnakeName string `json:"snake_name"` // ERROR "json\(camel\): got 'snake_name' want 'snakeName'"
+	CamelName string `json:"camelName"`
+	Skipped   string `json:"-"`
+	Omitted   string `json:",omitempty"`
+	YAMLName  string `yaml:"yaml_name"`
+	KebabName string `yaml:"kebab-name,omitempty"` // ERROR "yaml\(snake\): got 'kebab-name' want 'kebab_name'"
+	Other     string `db:"other_name"`
+}
@@ -0,0 +1,28 @@
+//args: -Etenv
+package testdata
+
+import (
+	"os"
+	"testing"
+)
+
+func TestSetenv(t *testing.T) {
+	os.Setenv("GOLANGCI_LINT_ENV", "1") // ERROR "os.Setenv\(\) can be replaced by `t.Setenv\(\)` in TestSetenv"
+	defer os.Unsetenv("GOLANGCI_LINT_ENV") // ERROR "os.Unsetenv\(\) can be replaced by `t.Setenv\(\)` in TestSetenv"
+
+	t.Run("sub", func(st *testing.T) {
+		_ = os.Setenv("GOLANGCI_LINT_ENV", "2") // ERROR "os.Setenv\(\) can be replaced by `st.Setenv\(\)` in TestSetenv"
+	})
+}
+
+func TestTSetenv(t *testing.T) {
+	t.Setenv("GOLANGCI_LINT_ENV", "1")
+}
+
+func BenchmarkSetenv(b *testing.B) {
+	os.Setenv("GOLANGCI_LINT_ENV", "1") // ERROR "os.Setenv\(\) can be replaced by `b.Setenv\(\)` in BenchmarkSetenv"
+}
+
+func SetupEnv() {
+	os.Setenv("GOLANGCI_LINT_ENV", "1")
+}
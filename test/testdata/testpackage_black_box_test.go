@@ -0,0 +1,8 @@
+//args: -Etestpackage
+package testdata_test
+
+import "testing"
+
+func TestTestpackageBlackBox(t *testing.T) {
+	_ = t
+}
@@ -0,0 +1,5 @@
+//args: -Etestpackage
+package testdata
+
+// exported for tests of the testdata_test package
+var TestpackageExported = 1
@@ -0,0 +1,8 @@
+//args: -Etestpackage
+package testdata // ERROR "package should be `testdata_test` instead of `testdata`"
+
+import "testing"
+
+func TestTestpackage(t *testing.T) {
+	_ = t
+}
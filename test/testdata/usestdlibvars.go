@@ -0,0 +1,32 @@
+//args: -Eusestdlibvars
+package testdata
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+func UsestdlibvarsMethod() (*http.Request, error) {
+	return http.NewRequest("GET", "http://example.com", nil) // ERROR "`\"GET\"` can be replaced by `http.MethodGet`"
+}
+
+func UsestdlibvarsMethodConst() (*http.Request, error) {
+	return http.NewRequest(http.MethodPost, "http://example.com", nil)
+}
+
+func UsestdlibvarsStatusCode(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" { // ERROR "`\"POST\"` can be replaced by `http.MethodPost`"
+		w.WriteHeader(405) // ERROR "`405` can be replaced by `http.StatusMethodNotAllowed`"
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func UsestdlibvarsWeekday(t time.Time) bool {
+	return t.Weekday() == 0 // ERROR "`0` can be replaced by `time.Sunday`"
+}
+
+func UsestdlibvarsUnrelatedString() {
+	fmt.Println("GET")
+}
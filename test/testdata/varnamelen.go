@@ -0,0 +1,25 @@
+//args: -Evarnamelen
+package testdata
+
+import "strings"
+
+func Join(words []string) string {
+	sb := strings.Builder{} // ERROR "variable name `sb` is too short for the scope of its usage"
+	for i, w := range words {
+		if i != 0 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(w)
+	}
+
+	sb.WriteString(".")
+	return sb.String()
+}
+
+func Sum(xs []int) int {
+	total := 0
+	for j := range xs {
+		total += xs[j]
+	}
+	return total
+}
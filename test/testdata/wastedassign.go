@@ -0,0 +1,67 @@
+//args: -Ewastedassign
+package testdata
+
+func wastedassignGet() int {
+	return 1
+}
+
+func WastedassignOverwritten() int {
+	x := 1 // ERROR "wasted assignment"
+	x = 2
+	return x
+}
+
+func WastedassignConditionallyUsed(cond bool) int {
+	x := 1
+	if cond {
+		x = 2
+	}
+	return x
+}
+
+func WastedassignOverwrittenInBothBranches(cond bool) int {
+	x := wastedassignGet() // ERROR "wasted assignment"
+	if cond {
+		x = 2
+	} else {
+		x = 3
+	}
+	return x
+}
+
+func WastedassignNotUsedAfterwards() int {
+	x := wastedassignGet()
+	y := x + 1
+	x = 2 // ERROR "reassigned, but never used afterwards"
+	return y
+}
+
+func WastedassignZeroValue(cond bool) int {
+	var x int
+	if cond {
+		x = wastedassignGet()
+	}
+	return x
+}
+
+func WastedassignLoop(n int) int {
+	sum := 0
+	for i := 0; i < n; i++ {
+		sum += i
+	}
+	return sum
+}
+
+func WastedassignClosure() int {
+	x := 1
+	f := func() int {
+		return x
+	}
+	x = 2
+	return f()
+}
+
+func WastedassignNamedResult() (x int) {
+	x = 1
+	return
+}
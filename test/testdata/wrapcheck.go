@@ -0,0 +1,44 @@
+//args: -Ewrapcheck
+package testdata
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+func WrapcheckBareVariable(data []byte) error {
+	var v interface{}
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err // ERROR "error returned from external package is unwrapped: sig: func encoding/json.Unmarshal"
+	}
+	return nil
+}
+
+func WrapcheckBareCall(data []byte) error {
+	var v interface{}
+	return json.Unmarshal(data, &v) // ERROR "error returned from external package is unwrapped: sig: func encoding/json.Unmarshal"
+}
+
+func WrapcheckInterface(r io.Reader) error {
+	_, err := r.Read(nil)
+	return err // ERROR "error returned from interface method should be wrapped: sig: func .io.Reader..Read"
+}
+
+func WrapcheckWrapped(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("can't decode: %w", err)
+	}
+	return nil
+}
+
+func wrapcheckLocal() error {
+	return fmt.Errorf("local")
+}
+
+func WrapcheckSamePackage() error {
+	err := wrapcheckLocal()
+	return err
+}